@@ -0,0 +1,13 @@
+# Retrieve the conditions, filters, and actions available to a project
+data "sentry_issue_alert_configuration" "default" {
+  organization = "my-organization"
+  project      = "my-project"
+}
+
+# Look up the ID of the PagerDuty action
+locals {
+  pagerduty_action_id = one([
+    for action in data.sentry_issue_alert_configuration.default.actions : action.id
+    if can(regex("PagerDuty", action.id))
+  ])
+}
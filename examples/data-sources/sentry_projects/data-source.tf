@@ -0,0 +1,8 @@
+# Retrieve the Go projects of a team
+data "sentry_projects" "default" {
+  organization = "my-organization"
+
+  team         = "my-team"
+  platform     = "go"
+  slug_pattern = "web-*"
+}
@@ -0,0 +1,7 @@
+# Retrieve the releases of a project
+data "sentry_releases" "default" {
+  organization = "my-organization"
+
+  project = "web-app"
+  query   = "web-app@1."
+}
@@ -424,3 +424,43 @@ resource "sentry_issue_alert" "notification_alert" {
 EOT
   // ...
 }
+
+#
+# Use typed blocks instead of JSON strings
+#
+
+resource "sentry_issue_alert" "typed_alert" {
+  organization = sentry_project.main.organization
+  project      = sentry_project.main.id
+  name         = "My typed issue alert"
+
+  action_match = "any"
+  filter_match = "all"
+  frequency    = 30
+
+  condition {
+    first_seen_event {}
+  }
+  condition {
+    event_frequency {
+      comparison_type = "count"
+      value           = 500
+      interval        = "1h"
+    }
+  }
+
+  filter {
+    tagged_event {
+      key   = "environment"
+      match = "eq"
+      value = "production"
+    }
+  }
+
+  action {
+    mail_action {
+      target_type      = "IssueOwners"
+      fallthrough_type = "ActiveMembers"
+    }
+  }
+}
@@ -1 +1,2 @@
-terraform import sentry_notification_alert.default org-slug/action-id
+# import using the organization slug and action id:
+terraform import sentry_notification_action.default org-slug/action-id
@@ -4,4 +4,10 @@ resource "sentry_organization" "default" {
   slug = "my-organization"
 
   agree_terms = true
+
+  # Security and privacy settings
+  require_2fa        = true
+  scrub_ip_addresses = true
+  data_scrubber      = true
+  sensitive_fields   = ["api_key"]
 }
@@ -0,0 +1,3 @@
+# import using the organization slug from the URL:
+# https://sentry.io/organizations/[org-slug]/issues/
+terraform import sentry_organization_settings.default org-slug
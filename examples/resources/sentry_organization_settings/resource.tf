@@ -0,0 +1,9 @@
+# Enforce security and privacy settings on an existing organization
+resource "sentry_organization_settings" "default" {
+  organization = "my-organization"
+
+  require_2fa        = true
+  scrub_ip_addresses = true
+  data_scrubber      = true
+  sensitive_fields   = ["api_key"]
+}
@@ -10,4 +10,16 @@ resource "sentry_project" "default" {
   resolve_age = 720
 
   default_rules = false
+
+  # Review grouping changes in pull requests rather than in the UI
+  fingerprinting_rules = <<EOT
+error.type:DatabaseUnavailable -> system-down
+EOT
+
+  scrub_ip_addresses = true
+  sensitive_fields   = ["api_key"]
+
+  options = {
+    "sentry:token_header" = "X-Sentry-Token"
+  }
 }
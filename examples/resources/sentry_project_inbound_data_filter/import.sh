@@ -0,0 +1,2 @@
+# import using the organization, project slugs and filter id:
+terraform import sentry_project_inbound_data_filter.default org-slug/project-slug/filter-id
@@ -0,0 +1,13 @@
+# Manage the ownership rules of a project
+resource "sentry_project_ownership" "default" {
+  organization = "my-organization"
+  project      = "web-app"
+
+  raw = <<EOT
+path:src/checkout/* #checkout-team
+url:https://example.com/checkout/* #checkout-team
+EOT
+
+  fallthrough     = false
+  auto_assignment = true
+}
@@ -1 +1,2 @@
+# import using the organization slug, project slug and symbol source id:
 terraform import sentry_project_symbol_source.default org-slug/project-slug/symbol-source-id
@@ -0,0 +1,2 @@
+# import using the organization slug and release version:
+terraform import sentry_release.default org-slug/version
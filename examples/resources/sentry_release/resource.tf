@@ -0,0 +1,15 @@
+# Create a release, associate commits with it and finalize it
+resource "sentry_release" "default" {
+  organization = "my-organization"
+  version      = "web-app@1.0.0"
+  projects     = ["web-app"]
+
+  url           = "https://github.com/my-organization/web-app/releases/tag/1.0.0"
+  date_released = "2023-01-01T10:00:00Z"
+
+  refs {
+    repository      = "my-organization/web-app"
+    commit          = "6ba09a7c53235ee8a8fa5ee4c1ca8ca886e7fdbb"
+    previous_commit = "8cb1cd9cf0ff24ee1cc3a9e66ca4a2cb2b0e14e6"
+  }
+}
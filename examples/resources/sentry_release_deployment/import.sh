@@ -0,0 +1,2 @@
+# import using the organization slug, release version and deploy id:
+terraform import sentry_release_deployment.default org-slug/version/deploy-id
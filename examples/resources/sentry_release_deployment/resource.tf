@@ -0,0 +1,12 @@
+# Record a deploy of a release to the production environment
+resource "sentry_release_deployment" "default" {
+  organization = "my-organization"
+  version      = "web-app@1.0.0"
+  environment  = "production"
+
+  name          = "Deploy 1.0.0"
+  url           = "https://ci.example.com/pipelines/1234"
+  date_started  = "2023-01-01T10:00:00Z"
+  date_finished = "2023-01-01T10:05:00Z"
+  projects      = ["web-app"]
+}
@@ -2,6 +2,7 @@ package sentry
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"sync"
 	"time"
@@ -11,30 +12,64 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"golang.org/x/oauth2"
-	"golang.org/x/sync/semaphore"
 )
 
+const defaultBaseURL = "https://sentry.io/api/"
+
 // Config is the configuration structure used to instantiate the Sentry
 // provider.
 type Config struct {
 	UserAgent string
 	Token     string
 	BaseURL   string
+	Region    string
+
+	// MaxRetries is the maximum number of retries of a failed or rate limited request.
+	MaxRetries int
+	// MinBackoff and MaxBackoff bound the wait time between retries.
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+	// RequestTimeout limits the time of a single request attempt. Zero means no timeout.
+	RequestTimeout time.Duration
+	// MaxConcurrentRequests limits the number of requests in flight. Zero means the
+	// limit reported by Sentry is used.
+	MaxConcurrentRequests int
+	// LogHTTPBodies includes request and response bodies in the trace logs.
+	LogHTTPBodies bool
+	// SkipScopeCheck disables looking up the scopes of the auth token.
+	SkipScopeCheck bool
+	// AlertTaskPolling configures how alerts that Sentry creates asynchronously
+	// are polled. A zero interval keeps the defaults of the client, and a zero
+	// timeout waits until the context of the operation is done.
+	AlertTaskPolling sentry.TaskPolling
 }
 
 // Client to connect to Sentry.
 func (c *Config) Client(ctx context.Context) (interface{}, diag.Diagnostics) {
 	tflog.Info(ctx, "Instantiating Sentry client...")
 
+	// Log requests
+	loggingHTTPClient := &http.Client{
+		Transport: &loggingTransport{
+			Delegate:  http.DefaultTransport,
+			LogBodies: c.LogHTTPBodies,
+		},
+	}
+
 	// Authentication
 	ts := oauth2.StaticTokenSource(&oauth2.Token{AccessToken: c.Token})
-	oauth2HTTPClient := oauth2.NewClient(ctx, ts)
+	oauth2HTTPClient := oauth2.NewClient(context.WithValue(ctx, oauth2.HTTPClient, loggingHTTPClient), ts)
+	oauth2HTTPClient.Timeout = c.RequestTimeout
 
 	// Handle rate limit
 	retryClient := retryablehttp.NewClient()
 	retryClient.HTTPClient = oauth2HTTPClient
 	retryClient.ErrorHandler = retryablehttp.PassthroughErrorHandler
 	retryClient.Logger = nil // Disable DEBUG logs
+	retryClient.RequestLogHook = logRetryAttempt
+	retryClient.RetryMax = c.MaxRetries
+	retryClient.RetryWaitMin = c.MinBackoff
+	retryClient.RetryWaitMax = c.MaxBackoff
 	retryClient.Backoff = func(min, max time.Duration, attemptNum int, resp *http.Response) time.Duration {
 		if rateLimitErr, ok := sentry.CheckResponse(resp).(*sentry.RateLimitError); ok {
 			return time.Until(rateLimitErr.Rate.Reset)
@@ -46,16 +81,28 @@ func (c *Config) Client(ctx context.Context) (interface{}, diag.Diagnostics) {
 	// Handle concurrency limit
 	semaphoreHTTPClient := &http.Client{
 		Transport: &semaphoreTransport{
-			Delegate: retryHTTPClient.Transport,
+			Delegate:              retryHTTPClient.Transport,
+			MaxConcurrentRequests: c.MaxConcurrentRequests,
 		},
 	}
 
 	// Initialize client
 	var cl *sentry.Client
 	var err error
-	if c.BaseURL == "" {
+	switch {
+	case c.Region != "":
+		if c.BaseURL != "" && c.BaseURL != defaultBaseURL {
+			return nil, diag.Errorf("region %q cannot be used with the custom base URL %q", c.Region, c.BaseURL)
+		}
+		cl, err = sentry.NewOnPremiseClient(sentry.RegionBaseURL(c.Region), semaphoreHTTPClient)
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+	case c.BaseURL == "" || c.BaseURL == defaultBaseURL:
+		// Organizations on sentry.io are hosted in different regions.
 		cl = sentry.NewClient(semaphoreHTTPClient)
-	} else {
+		cl.RegionRouting = true
+	default:
 		cl, err = sentry.NewOnPremiseClient(c.BaseURL, semaphoreHTTPClient)
 		if err != nil {
 			return nil, diag.FromErr(err)
@@ -64,39 +111,136 @@ func (c *Config) Client(ctx context.Context) (interface{}, diag.Diagnostics) {
 
 	// Set user agent
 	cl.UserAgent = c.UserAgent
+	if c.AlertTaskPolling.Interval > 0 {
+		cl.TaskPolling = c.AlertTaskPolling
+	}
 
-	return cl, nil
+	if c.SkipScopeCheck {
+		return cl, nil
+	}
+	return cl, c.loadTokenScopes(ctx, cl)
 }
 
+// loadTokenScopes caches the scopes of the auth token on the client, so that
+// resources can check them before calling Sentry. Failing to look them up
+// only disables the check, unless the token is rejected.
+func (c *Config) loadTokenScopes(ctx context.Context, cl *sentry.Client) diag.Diagnostics {
+	scopes, resp, err := cl.LoadTokenScopes(ctx)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusUnauthorized {
+			return diag.Errorf("the auth token was rejected by Sentry: %v", err)
+		}
+		return diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  "Unable to look up the scopes of the auth token",
+			Detail:   fmt.Sprintf("Required scopes are not checked before calling Sentry: %v", err),
+		}}
+	}
+	if scopes == nil {
+		return diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  "Unable to look up the scopes of the auth token",
+			Detail:   "Sentry did not report the scopes of the auth token. Required scopes are not checked before calling Sentry.",
+		}}
+	}
+	tflog.Debug(ctx, "Loaded auth token scopes", map[string]interface{}{
+		"scopes": scopes,
+	})
+	return nil
+}
+
+// semaphoreTransport limits the number of concurrent requests. Unless
+// MaxConcurrentRequests is set, requests are sent one at a time until Sentry
+// reports its concurrency limit, and the limit follows later reports.
 type semaphoreTransport struct {
-	Delegate http.RoundTripper
+	Delegate              http.RoundTripper
+	MaxConcurrentRequests int
 
-	mu sync.RWMutex
-	w  *semaphore.Weighted
+	once sync.Once
+	sem  *resizableSemaphore
 }
 
 func (t *semaphoreTransport) RoundTrip(req *http.Request) (*http.Response, error) {
-	t.mu.RLock()
-	if t.w == nil {
-		t.mu.RUnlock()
-		t.mu.Lock()
-		resp, err := t.Delegate.RoundTrip(req)
-		if resp != nil {
-			rate := sentry.ParseRate(resp)
-			if rate.ConcurrentLimit > 0 {
-				t.w = semaphore.NewWeighted(int64(rate.ConcurrentLimit))
-			}
+	t.once.Do(func() {
+		limit := t.MaxConcurrentRequests
+		if limit <= 0 {
+			limit = 1
 		}
-		t.mu.Unlock()
-		return resp, err
-	}
-	t.mu.RUnlock()
+		t.sem = newResizableSemaphore(limit)
+	})
 
-	ctx := req.Context()
-	if err := t.w.Acquire(ctx, 1); err != nil {
+	if err := t.sem.Acquire(req.Context()); err != nil {
 		return nil, err
 	}
-	defer t.w.Release(1)
+	defer t.sem.Release()
+
+	resp, err := t.Delegate.RoundTrip(req)
+	if resp != nil && t.MaxConcurrentRequests <= 0 {
+		if rate := sentry.ParseRate(resp); rate.ConcurrentLimit > 0 {
+			t.sem.SetLimit(rate.ConcurrentLimit)
+		}
+	}
+	return resp, err
+}
+
+// resizableSemaphore is a counting semaphore whose limit can change while it
+// is in use.
+type resizableSemaphore struct {
+	mu      sync.Mutex
+	limit   int
+	inUse   int
+	changed chan struct{}
+}
+
+func newResizableSemaphore(limit int) *resizableSemaphore {
+	return &resizableSemaphore{
+		limit:   limit,
+		changed: make(chan struct{}),
+	}
+}
+
+// Acquire blocks until a slot is available or ctx is done.
+func (s *resizableSemaphore) Acquire(ctx context.Context) error {
+	for {
+		s.mu.Lock()
+		if s.inUse < s.limit {
+			s.inUse++
+			s.mu.Unlock()
+			return nil
+		}
+		changed := s.changed
+		s.mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-changed:
+		}
+	}
+}
+
+// Release frees a slot acquired by Acquire.
+func (s *resizableSemaphore) Release() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.inUse--
+	s.notify()
+}
+
+// SetLimit changes the number of slots. Slots in use above a lowered limit
+// are not revoked, but no new ones are handed out until enough are released.
+func (s *resizableSemaphore) SetLimit(limit int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if limit == s.limit {
+		return
+	}
+	s.limit = limit
+	s.notify()
+}
 
-	return t.Delegate.RoundTrip(req)
+// notify wakes up all waiters. It must be called with mu held.
+func (s *resizableSemaphore) notify() {
+	close(s.changed)
+	s.changed = make(chan struct{})
 }
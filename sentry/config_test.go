@@ -0,0 +1,105 @@
+package sentry
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestResizableSemaphore(t *testing.T) {
+	ctx := context.Background()
+	s := newResizableSemaphore(1)
+
+	if err := s.Acquire(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	// The only slot is in use.
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	if err := s.Acquire(timeoutCtx); err != context.DeadlineExceeded {
+		t.Fatalf("expected %v, got %v", context.DeadlineExceeded, err)
+	}
+
+	// Raising the limit wakes up waiters.
+	acquired := make(chan error)
+	go func() { acquired <- s.Acquire(ctx) }()
+	s.SetLimit(2)
+	select {
+	case err := <-acquired:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected a slot after raising the limit")
+	}
+
+	// Lowering the limit keeps slots in use, but blocks new ones until enough are released.
+	s.SetLimit(1)
+	go func() { acquired <- s.Acquire(ctx) }()
+	s.Release()
+	select {
+	case <-acquired:
+		t.Fatal("expected no slot while the limit is exceeded")
+	case <-time.After(10 * time.Millisecond):
+	}
+	s.Release()
+	select {
+	case err := <-acquired:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected a slot after releasing")
+	}
+}
+
+type roundTripFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestSemaphoreTransport_followsConcurrentLimit(t *testing.T) {
+	var inFlight, maxInFlight int32
+	transport := &semaphoreTransport{
+		Delegate: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			n := atomic.AddInt32(&inFlight, 1)
+			defer atomic.AddInt32(&inFlight, -1)
+			for {
+				m := atomic.LoadInt32(&maxInFlight)
+				if n <= m || atomic.CompareAndSwapInt32(&maxInFlight, m, n) {
+					break
+				}
+			}
+			time.Sleep(5 * time.Millisecond)
+
+			header := http.Header{}
+			header.Set("X-Sentry-Rate-Limit-ConcurrentLimit", "3")
+			return &http.Response{StatusCode: http.StatusOK, Header: header, Request: req}, nil
+		}),
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			req, _ := http.NewRequest("GET", "https://sentry.io/api/0/", nil)
+			if _, err := transport.RoundTrip(req); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got := atomic.LoadInt32(&maxInFlight); got > 3 {
+		t.Errorf("expected at most 3 concurrent requests, got %d", got)
+	}
+	if limit := transport.sem.limit; limit != 3 {
+		t.Errorf("expected the limit to follow Sentry, got %d", limit)
+	}
+}
@@ -0,0 +1,186 @@
+package sentry
+
+import (
+	"context"
+	"sort"
+
+	"github.com/getkevin/terraform-provider-sentry/sentry/lib"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceSentryIssueAlertConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Description: "Sentry Issue Alert Configuration data source. Lists the conditions, filters, and actions " +
+			"available to the issue alerts of a project, e.g. to look up the `id` and form fields of an " +
+			"action of an integration.",
+
+		ReadContext: dataSourceSentryIssueAlertConfigurationRead,
+
+		Schema: map[string]*schema.Schema{
+			"organization": {
+				Description: "The slug of the organization the project belongs to.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"project": {
+				Description: "The slug of the project.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"conditions": issueAlertConfigurationEntriesSchema("The available conditions."),
+			"filters":    issueAlertConfigurationEntriesSchema("The available filters."),
+			"actions":    issueAlertConfigurationEntriesSchema("The available actions."),
+		},
+	}
+}
+
+func issueAlertConfigurationEntriesSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Description: description,
+		Type:        schema.TypeList,
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"id": {
+					Description: "The ID to use in the `conditions`, `filters`, or `actions` of an issue alert.",
+					Type:        schema.TypeString,
+					Computed:    true,
+				},
+				"label": {
+					Description: "The label, with the form fields as placeholders.",
+					Type:        schema.TypeString,
+					Computed:    true,
+				},
+				"prompt": {
+					Description: "The prompt shown when choosing the entry.",
+					Type:        schema.TypeString,
+					Computed:    true,
+				},
+				"enabled": {
+					Description: "Whether the entry can be used.",
+					Type:        schema.TypeBool,
+					Computed:    true,
+				},
+				"form_fields": {
+					Description: "The form fields of the entry.",
+					Type:        schema.TypeList,
+					Computed:    true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"name": {
+								Description: "The key of the field.",
+								Type:        schema.TypeString,
+								Computed:    true,
+							},
+							"type": {
+								Description: "The type of the field, e.g. `choice`, `string`, or `number`.",
+								Type:        schema.TypeString,
+								Computed:    true,
+							},
+							"placeholder": {
+								Description: "The placeholder of the field.",
+								Type:        schema.TypeString,
+								Computed:    true,
+							},
+							"choices": {
+								Description: "The choices of a `choice` field.",
+								Type:        schema.TypeList,
+								Computed:    true,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"value": {
+											Description: "The value to send.",
+											Type:        schema.TypeString,
+											Computed:    true,
+										},
+										"label": {
+											Description: "The label of the choice.",
+											Type:        schema.TypeString,
+											Computed:    true,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceSentryIssueAlertConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*sentry.Client)
+
+	org := d.Get("organization").(string)
+	project := d.Get("project").(string)
+
+	tflog.Debug(ctx, "Reading issue alert configuration", map[string]interface{}{
+		"org":     org,
+		"project": project,
+	})
+	configuration, _, err := client.IssueAlerts.GetConfiguration(ctx, org, project)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(buildTwoPartID(org, project))
+	retErr := multierror.Append(
+		d.Set("organization", org),
+		d.Set("project", project),
+		d.Set("conditions", flattenIssueAlertConfigurationEntries(configuration.Conditions)),
+		d.Set("filters", flattenIssueAlertConfigurationEntries(configuration.Filters)),
+		d.Set("actions", flattenIssueAlertConfigurationEntries(configuration.Actions)),
+	)
+	return diag.FromErr(retErr.ErrorOrNil())
+}
+
+func flattenIssueAlertConfigurationEntries(entries []*sentry.IssueAlertConfigurationEntry) []interface{} {
+	out := make([]interface{}, 0, len(entries))
+	for _, entry := range entries {
+		names := make([]string, 0, len(entry.FormFields))
+		for name := range entry.FormFields {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		fields := make([]interface{}, 0, len(names))
+		for _, name := range names {
+			field := entry.FormFields[name]
+
+			choices := make([]interface{}, 0, len(field.Choices))
+			for _, choice := range field.Choices {
+				if len(choice) != 2 {
+					continue
+				}
+				choices = append(choices, map[string]interface{}{
+					"value": issueAlertValueString(choice[0]),
+					"label": issueAlertValueString(choice[1]),
+				})
+			}
+
+			placeholder := ""
+			if field.Placeholder != nil {
+				placeholder = issueAlertValueString(field.Placeholder)
+			}
+			fields = append(fields, map[string]interface{}{
+				"name":        name,
+				"type":        field.Type,
+				"placeholder": placeholder,
+				"choices":     choices,
+			})
+		}
+
+		out = append(out, map[string]interface{}{
+			"id":          entry.ID,
+			"label":       entry.Label,
+			"prompt":      entry.Prompt,
+			"enabled":     entry.Enabled,
+			"form_fields": fields,
+		})
+	}
+	return out
+}
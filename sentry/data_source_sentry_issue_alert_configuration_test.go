@@ -0,0 +1,43 @@
+package sentry
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccSentryIssueAlertConfigurationDataSource_basic(t *testing.T) {
+	teamName := acctest.RandomWithPrefix("tf-team")
+	projectName := acctest.RandomWithPrefix("tf-project")
+	dn := "data.sentry_issue_alert_configuration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSentryProjectConfig_team(teamName, projectName) + `
+data "sentry_issue_alert_configuration" "test" {
+	organization = sentry_project.test.organization
+	project      = sentry_project.test.id
+}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dn, "organization", testOrganization),
+					resource.TestCheckResourceAttr(dn, "project", projectName),
+					resource.TestCheckTypeSetElemNestedAttrs(dn, "conditions.*", map[string]string{
+						"id":      "sentry.rules.conditions.first_seen_event.FirstSeenEventCondition",
+						"enabled": "true",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(dn, "filters.*", map[string]string{
+						"id": "sentry.rules.filters.level.LevelFilter",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(dn, "actions.*", map[string]string{
+						"id": "sentry.mail.actions.NotifyEmailAction",
+					}),
+				),
+			},
+		},
+	})
+}
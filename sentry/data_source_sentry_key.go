@@ -106,18 +106,11 @@ func dataSourceSentryKeyRead(ctx context.Context, d *schema.ResourceData, meta i
 		"project": project,
 	})
 
-	listParams := &sentry.ListCursorParams{}
-	var allKeys []*sentry.ProjectKey
-	for {
-		keys, resp, err := client.ProjectKeys.List(ctx, org, project, listParams)
-		if err != nil {
-			return diag.FromErr(err)
-		}
-		allKeys = append(allKeys, keys...)
-		if resp.Cursor == "" {
-			break
-		}
-		listParams.Cursor = resp.Cursor
+	allKeys, err := sentry.ListAll(ctx, nil, func(ctx context.Context, cursor sentry.ListCursorParams) ([]*sentry.ProjectKey, *sentry.Response, error) {
+		return client.ProjectKeys.List(ctx, org, project, &cursor)
+	})
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	if v, ok := d.GetOk("name"); ok {
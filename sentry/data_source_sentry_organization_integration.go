@@ -51,23 +51,15 @@ func dataSourceSentryOrganizationIntegrationRead(ctx context.Context, d *schema.
 	tflog.Debug(ctx, "Reading organization integration", map[string]interface{}{"org": org, "provider_key": providerKey, "name": integrationName})
 
 	// get all paginated integrations with the provider key
-	var orgIntegrations []*sentry.OrganizationIntegration
 	params := &sentry.ListOrganizationIntegrationsParams{
-		ListCursorParams: sentry.ListCursorParams{},
-		ProviderKey:      providerKey,
+		ProviderKey: providerKey,
 	}
-	for {
-		keys, resp, err := client.OrganizationIntegrations.List(ctx, org, params)
-		if err != nil {
-			return diag.FromErr(err)
-		}
-		orgIntegrations = append(orgIntegrations, keys...)
-
-		tflog.Debug(ctx, "Requested organization integration list cursor", map[string]interface{}{"cursor": resp.Cursor})
-		if resp.Cursor == "" {
-			break
-		}
-		params.ListCursorParams.Cursor = resp.Cursor
+	orgIntegrations, err := sentry.ListAll(ctx, nil, func(ctx context.Context, cursor sentry.ListCursorParams) ([]*sentry.OrganizationIntegration, *sentry.Response, error) {
+		params.ListCursorParams = cursor
+		return client.OrganizationIntegrations.List(ctx, org, params)
+	})
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	// filter for first matching name
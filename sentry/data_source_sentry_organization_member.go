@@ -0,0 +1,111 @@
+package sentry
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/getkevin/terraform-provider-sentry/sentry/lib"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceSentryOrganizationMember() *schema.Resource {
+	return &schema.Resource{
+		Description: "Retrieve an organization member by email.",
+
+		ReadContext: dataSourceSentryOrganizationMemberRead,
+
+		Schema: map[string]*schema.Schema{
+			"organization": {
+				Description: "The slug of the organization.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"email": {
+				Description: "The email of the organization member.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"role": {
+				Description: "This is the role of the organization member.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"teams": {
+				Description: "The slugs of the teams the organization member belongs to.",
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"user_id": {
+				Description: "The ID of the user account of the organization member. Empty while the invite is pending.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"pending": {
+				Description: "The invite is pending.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"expired": {
+				Description: "The invite has expired.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceSentryOrganizationMemberRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*sentry.Client)
+
+	org := d.Get("organization").(string)
+	email := d.Get("email").(string)
+
+	tflog.Debug(ctx, "Reading organization member", map[string]interface{}{
+		"org":   org,
+		"email": email,
+	})
+
+	params := &sentry.ListOrganizationMembersParams{
+		Query: "email:" + email,
+	}
+
+	var member *sentry.OrganizationMember
+	_, err := sentry.ForEachPage(ctx, nil, func(ctx context.Context, cursor sentry.ListCursorParams) ([]*sentry.OrganizationMember, *sentry.Response, error) {
+		params.ListCursorParams = cursor
+		return client.OrganizationMembers.List(ctx, org, params)
+	}, func(members []*sentry.OrganizationMember) error {
+		for _, m := range members {
+			if strings.EqualFold(m.Email, email) {
+				member = m
+				return sentry.ErrStopPagination
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if member == nil {
+		return diag.FromErr(fmt.Errorf("could not find organization member with email %q in organization %q", email, org))
+	}
+
+	d.SetId(member.ID)
+	retErr := multierror.Append(
+		d.Set("organization", org),
+		d.Set("email", member.Email),
+		d.Set("role", member.Role),
+		d.Set("teams", flattenStringSet(member.Teams)),
+		d.Set("user_id", member.User.ID),
+		d.Set("pending", member.Pending),
+		d.Set("expired", member.Expired),
+	)
+	return diag.FromErr(retErr.ErrorOrNil())
+}
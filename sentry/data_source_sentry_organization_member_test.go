@@ -0,0 +1,38 @@
+package sentry
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccSentryOrganizationMemberDataSource_basic(t *testing.T) {
+	memberEmail := acctest.RandomWithPrefix("tf-member") + "@example.com"
+	rn := "sentry_organization_member.john_doe"
+	dn := "data.sentry_organization_member.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSentryOrganizationMemberConfig(memberEmail, "member") + `
+data "sentry_organization_member" "test" {
+	organization = sentry_organization_member.john_doe.organization
+	email        = sentry_organization_member.john_doe.email
+}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dn, "id", rn, "internal_id"),
+					resource.TestCheckResourceAttrPair(dn, "organization", rn, "organization"),
+					resource.TestCheckResourceAttrPair(dn, "email", rn, "email"),
+					resource.TestCheckResourceAttrPair(dn, "role", rn, "role"),
+					resource.TestCheckResourceAttr(dn, "pending", "true"),
+					resource.TestCheckResourceAttr(dn, "expired", "false"),
+					resource.TestCheckResourceAttr(dn, "user_id", ""),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,102 @@
+package sentry
+
+import (
+	"context"
+
+	"github.com/getkevin/terraform-provider-sentry/sentry/lib"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceSentryProject() *schema.Resource {
+	return &schema.Resource{
+		Description: "Sentry Project data source.",
+
+		ReadContext: dataSourceSentryProjectRead,
+
+		Schema: map[string]*schema.Schema{
+			"organization": {
+				Description: "The slug of the organization the project belongs to.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"slug": {
+				Description: "The unique URL slug for this project.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"internal_id": {
+				Description: "The internal ID for this project.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"name": {
+				Description: "The human readable name for this project.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"platform": {
+				Description: "The platform of this project.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"teams": {
+				Description: "The slugs of the teams that own this project.",
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"status": {
+				Description: "The status of this project.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"is_public": {
+				Description: "Whether the project is public.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceSentryProjectRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*sentry.Client)
+
+	org := d.Get("organization").(string)
+	projectSlug := d.Get("slug").(string)
+
+	tflog.Debug(ctx, "Reading project", map[string]interface{}{
+		"org":     org,
+		"project": projectSlug,
+	})
+	proj, _, err := client.Projects.Get(ctx, org, projectSlug)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(proj.Slug)
+	retErr := multierror.Append(
+		d.Set("organization", org),
+		d.Set("slug", proj.Slug),
+		d.Set("internal_id", proj.ID),
+		d.Set("name", proj.Name),
+		d.Set("platform", proj.Platform),
+		d.Set("teams", flattenStringSet(projectTeamSlugs(proj))),
+		d.Set("status", proj.Status),
+		d.Set("is_public", proj.IsPublic),
+	)
+	return diag.FromErr(retErr.ErrorOrNil())
+}
+
+func projectTeamSlugs(proj *sentry.Project) []string {
+	teams := make([]string, 0, len(proj.Teams))
+	for _, team := range proj.Teams {
+		teams = append(teams, sentry.StringValue(team.Slug))
+	}
+	return teams
+}
@@ -0,0 +1,40 @@
+package sentry
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccSentryProjectDataSource_basic(t *testing.T) {
+	teamName := acctest.RandomWithPrefix("tf-team")
+	projectName := acctest.RandomWithPrefix("tf-project")
+	rn := "sentry_project.test"
+	dn := "data.sentry_project.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSentryProjectConfig_team(teamName, projectName) + `
+data "sentry_project" "test" {
+	organization = sentry_project.test.organization
+	slug         = sentry_project.test.id
+}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dn, "organization", rn, "organization"),
+					resource.TestCheckResourceAttrPair(dn, "slug", rn, "id"),
+					resource.TestCheckResourceAttrPair(dn, "internal_id", rn, "internal_id"),
+					resource.TestCheckResourceAttrPair(dn, "name", rn, "name"),
+					resource.TestCheckResourceAttrPair(dn, "platform", rn, "platform"),
+					resource.TestCheckResourceAttr(dn, "teams.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(dn, "teams.*", "sentry_team.test", "id"),
+					resource.TestCheckResourceAttrSet(dn, "is_public"),
+				),
+			},
+		},
+	})
+}
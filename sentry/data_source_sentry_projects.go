@@ -0,0 +1,146 @@
+package sentry
+
+import (
+	"context"
+	"path"
+
+	"github.com/getkevin/terraform-provider-sentry/sentry/lib"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceSentryProjects() *schema.Resource {
+	return &schema.Resource{
+		Description: "Sentry Projects data source. Lists the projects of an organization, optionally filtered by team, platform or slug.",
+
+		ReadContext: dataSourceSentryProjectsRead,
+
+		Schema: map[string]*schema.Schema{
+			"organization": {
+				Description: "The slug of the organization the projects belong to.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"team": {
+				Description: "Only list the projects owned by the team with this slug.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"platform": {
+				Description: "Only list the projects with this platform.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"slug_pattern": {
+				Description: "Only list the projects whose slug matches this shell pattern, e.g. `web-*`.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				ValidateFunc: func(i interface{}, k string) ([]string, []error) {
+					if _, err := path.Match(i.(string), ""); err != nil {
+						return nil, []error{err}
+					}
+					return nil, nil
+				},
+			},
+			"projects": {
+				Description: "The list of matching projects.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"slug": {
+							Description: "The unique URL slug for this project.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"internal_id": {
+							Description: "The internal ID for this project.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"name": {
+							Description: "The human readable name for this project.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"platform": {
+							Description: "The platform of this project.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"teams": {
+							Description: "The slugs of the teams that own this project.",
+							Type:        schema.TypeSet,
+							Computed:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceSentryProjectsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*sentry.Client)
+
+	org := d.Get("organization").(string)
+	team := d.Get("team").(string)
+	platform := d.Get("platform").(string)
+	slugPattern := d.Get("slug_pattern").(string)
+
+	tflog.Debug(ctx, "Reading projects", map[string]interface{}{
+		"org":         org,
+		"team":        team,
+		"platform":    platform,
+		"slugPattern": slugPattern,
+	})
+
+	params := &sentry.ListProjectsParams{}
+	if team != "" {
+		params.Query = "team:" + team
+	}
+
+	projects, err := sentry.ListAll(ctx, nil, func(ctx context.Context, cursor sentry.ListCursorParams) ([]*sentry.Project, *sentry.Response, error) {
+		params.ListCursorParams = cursor
+		return client.Projects.List(ctx, org, params)
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	filtered := make([]interface{}, 0, len(projects))
+	for _, proj := range projects {
+		teams := projectTeamSlugs(proj)
+		if team != "" && !containsString(teams, team) {
+			continue
+		}
+		if platform != "" && proj.Platform != platform {
+			continue
+		}
+		if slugPattern != "" {
+			if ok, _ := path.Match(slugPattern, proj.Slug); !ok {
+				continue
+			}
+		}
+
+		filtered = append(filtered, map[string]interface{}{
+			"slug":        proj.Slug,
+			"internal_id": proj.ID,
+			"name":        proj.Name,
+			"platform":    proj.Platform,
+			"teams":       flattenStringSet(teams),
+		})
+	}
+
+	d.SetId(org)
+	retErr := multierror.Append(
+		d.Set("organization", org),
+		d.Set("projects", filtered),
+	)
+	return diag.FromErr(retErr.ErrorOrNil())
+}
@@ -0,0 +1,44 @@
+package sentry
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccSentryProjectsDataSource_basic(t *testing.T) {
+	teamName := acctest.RandomWithPrefix("tf-team")
+	projectName := acctest.RandomWithPrefix("tf-project")
+	rn := "sentry_project.test"
+	dn := "data.sentry_projects.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSentryProjectConfig_team(teamName, projectName) + `
+data "sentry_projects" "test" {
+	organization = sentry_project.test.organization
+	team         = sentry_team.test.id
+	slug_pattern = "tf-project-*"
+}
+
+data "sentry_projects" "none" {
+	organization = sentry_project.test.organization
+	team         = sentry_team.test.id
+	platform     = "unknown-platform"
+}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dn, "projects.#", "1"),
+					resource.TestCheckResourceAttrPair(dn, "projects.0.slug", rn, "id"),
+					resource.TestCheckResourceAttrPair(dn, "projects.0.internal_id", rn, "internal_id"),
+					resource.TestCheckResourceAttrPair(dn, "projects.0.name", rn, "name"),
+					resource.TestCheckResourceAttr("data.sentry_projects.none", "projects.#", "0"),
+				),
+			},
+		},
+	})
+}
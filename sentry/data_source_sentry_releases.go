@@ -0,0 +1,172 @@
+package sentry
+
+import (
+	"context"
+
+	"github.com/getkevin/terraform-provider-sentry/sentry/lib"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceSentryReleases() *schema.Resource {
+	return &schema.Resource{
+		Description: "Sentry Releases data source. Lists the releases of an organization.",
+
+		ReadContext: dataSourceSentryReleasesRead,
+
+		Schema: map[string]*schema.Schema{
+			"organization": {
+				Description: "The slug of the organization the releases belong to.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"project": {
+				Description: "Only list the releases of the project with this slug.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"query": {
+				Description: "Only list the releases whose version starts with this value.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"releases": {
+				Description: "The list of releases, newest first.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"version": {
+							Description: "The version identifier of the release.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"short_version": {
+							Description: "The short version of the release.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"ref": {
+							Description: "The commit reference of the release.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"url": {
+							Description: "The URL that points to the release.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"date_created": {
+							Description: "The RFC 3339 timestamp of when the release was created.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"date_released": {
+							Description: "The RFC 3339 timestamp of when the release went live. Empty if the release is not finalized.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"commit_count": {
+							Description: "The number of commits associated with the release.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"deploy_count": {
+							Description: "The number of deploys of the release.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"new_groups": {
+							Description: "The number of new issues first seen in the release.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"projects": {
+							Description: "The slugs of the projects the release belongs to.",
+							Type:        schema.TypeSet,
+							Computed:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"internal_id": {
+							Description: "The internal ID for this release.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceSentryReleasesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*sentry.Client)
+
+	org := d.Get("organization").(string)
+	project := d.Get("project").(string)
+	query := d.Get("query").(string)
+
+	params := &sentry.ListReleasesParams{
+		Query: query,
+	}
+	if project != "" {
+		// The releases endpoint filters by project ID rather than slug.
+		proj, _, err := client.Projects.Get(ctx, org, project)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		params.Project = proj.ID
+	}
+
+	tflog.Debug(ctx, "Reading releases", map[string]interface{}{
+		"org":     org,
+		"project": project,
+		"query":   query,
+	})
+
+	releases, err := sentry.ListAll(ctx, nil, func(ctx context.Context, cursor sentry.ListCursorParams) ([]*sentry.Release, *sentry.Response, error) {
+		params.ListCursorParams = cursor
+		return client.Releases.List(ctx, org, params)
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(buildThreePartID(org, project, query))
+	retErr := multierror.Append(
+		d.Set("organization", org),
+		d.Set("project", project),
+		d.Set("query", query),
+		d.Set("releases", flattenReleases(releases)),
+	)
+	return diag.FromErr(retErr.ErrorOrNil())
+}
+
+func flattenReleases(releases []*sentry.Release) []interface{} {
+	out := make([]interface{}, 0, len(releases))
+	for _, release := range releases {
+		projects := make([]string, 0, len(release.Projects))
+		for _, project := range release.Projects {
+			projects = append(projects, project.Slug)
+		}
+
+		out = append(out, map[string]interface{}{
+			"version":       release.Version,
+			"short_version": release.ShortVersion,
+			"ref":           sentry.StringValue(release.Ref),
+			"url":           sentry.StringValue(release.URL),
+			"date_created":  flattenTime(&release.DateCreated),
+			"date_released": flattenTime(release.DateReleased),
+			"commit_count":  release.CommitCount,
+			"deploy_count":  release.DeployCount,
+			"new_groups":    release.NewGroups,
+			"projects":      flattenStringSet(projects),
+			"internal_id":   release.ID,
+		})
+	}
+	return out
+}
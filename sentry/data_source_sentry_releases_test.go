@@ -0,0 +1,39 @@
+package sentry
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccSentryReleasesDataSource_basic(t *testing.T) {
+	teamName := acctest.RandomWithPrefix("tf-team")
+	projectName := acctest.RandomWithPrefix("tf-project")
+	version := acctest.RandomWithPrefix("tf-release")
+	rn := "sentry_release.test"
+	dn := "data.sentry_releases.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSentryReleaseConfig(teamName, projectName, version, "") + `
+data "sentry_releases" "test" {
+	organization = sentry_release.test.organization
+	project      = sentry_project.test.id
+	query        = sentry_release.test.version
+}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dn, "releases.#", "1"),
+					resource.TestCheckResourceAttrPair(dn, "releases.0.version", rn, "version"),
+					resource.TestCheckResourceAttrPair(dn, "releases.0.internal_id", rn, "internal_id"),
+					resource.TestCheckResourceAttrPair(dn, "releases.0.date_created", rn, "date_created"),
+					resource.TestCheckResourceAttr(dn, "releases.0.projects.#", "1"),
+				),
+			},
+		},
+	})
+}
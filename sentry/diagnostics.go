@@ -0,0 +1,110 @@
+package sentry
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/getkevin/terraform-provider-sentry/sentry/lib"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// diagFromErr converts an error returned by the Sentry client to diagnostics.
+// Each field error of a validation error becomes a diagnostic pointing at the
+// matching attribute of the resource, so Terraform can show which part of the
+// configuration was rejected.
+func diagFromErr(err error, d *schema.ResourceData) diag.Diagnostics {
+	var validationErr *sentry.ValidationError
+	if !errors.As(err, &validationErr) || len(validationErr.FieldErrors) == 0 {
+		return diag.FromErr(err)
+	}
+
+	fields := make([]string, 0, len(validationErr.FieldErrors))
+	for field := range validationErr.FieldErrors {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	configType := d.GetRawConfig().Type()
+
+	var diags diag.Diagnostics
+	for _, field := range fields {
+		msg := strings.Join(validationErr.FieldErrors[field], " ")
+		diags = append(diags, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       fmt.Sprintf("Invalid %s: %s", field, msg),
+			Detail:        fmt.Sprintf("Sentry rejected the %s field: %s", field, msg),
+			AttributePath: attributePathFromField(configType, field),
+		})
+	}
+	return diags
+}
+
+// attributePathFromField returns the path of the attribute matching a field of
+// the Sentry API, e.g. "triggers.0.alertThreshold" becomes
+// triggers[0].alert_threshold. As much of the path as exists in the resource is
+// returned, or nil if not even the top-level attribute exists.
+func attributePathFromField(ty cty.Type, field string) cty.Path {
+	var path cty.Path
+	for _, part := range strings.Split(field, ".") {
+		switch {
+		case ty.IsObjectType():
+			name := toSnakeCase(part)
+			if !ty.HasAttribute(name) {
+				// Repeated blocks have singular names, e.g. the "triggers" of a
+				// metric alert are configured in "trigger" blocks.
+				name = strings.TrimSuffix(name, "s")
+				if !ty.HasAttribute(name) {
+					return path
+				}
+			}
+			path = path.GetAttr(name)
+			ty = ty.AttributeType(name)
+		case ty.IsListType() || ty.IsTupleType():
+			i, err := strconv.Atoi(part)
+			if err != nil {
+				return path
+			}
+			path = path.IndexInt(i)
+			if ty.IsListType() {
+				ty = ty.ElementType()
+			} else if i < len(ty.TupleElementTypes()) {
+				ty = ty.TupleElementType(i)
+			} else {
+				return path
+			}
+		default:
+			return path
+		}
+	}
+	return path
+}
+
+// toSnakeCase converts a camel case field name of the Sentry API to the snake
+// case used by attributes, e.g. "alertThreshold" to "alert_threshold".
+// Acronyms and numbers are kept together, e.g. "scrubIPAddresses" becomes
+// "scrub_ip_addresses" and "require2FA" becomes "require_2fa".
+func toSnakeCase(s string) string {
+	runes := []rune(s)
+	var b strings.Builder
+	for i, r := range runes {
+		if i > 0 {
+			prev := runes[i-1]
+			switch {
+			case unicode.IsUpper(r) && unicode.IsLower(prev):
+				b.WriteByte('_')
+			case unicode.IsUpper(r) && unicode.IsUpper(prev) && i+1 < len(runes) && unicode.IsLower(runes[i+1]):
+				b.WriteByte('_')
+			case unicode.IsDigit(r) && unicode.IsLetter(prev):
+				b.WriteByte('_')
+			}
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+	return b.String()
+}
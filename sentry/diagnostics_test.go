@@ -0,0 +1,71 @@
+package sentry
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/getkevin/terraform-provider-sentry/sentry/lib"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestToSnakeCase(t *testing.T) {
+	for input, expected := range map[string]string{
+		"name":             "name",
+		"alertThreshold":   "alert_threshold",
+		"timeWindow":       "time_window",
+		"scrubIPAddresses": "scrub_ip_addresses",
+		"require2FA":       "require_2fa",
+		"relayPiiConfig":   "relay_pii_config",
+	} {
+		if got := toSnakeCase(input); got != expected {
+			t.Errorf("toSnakeCase(%q) = %q, want %q", input, got, expected)
+		}
+	}
+}
+
+func TestAttributePathFromField(t *testing.T) {
+	ty := resourceSentryMetricAlert().CoreConfigSchema().ImpliedType()
+
+	for field, expected := range map[string]cty.Path{
+		"name":                      cty.GetAttrPath("name"),
+		"timeWindow":                cty.GetAttrPath("time_window"),
+		"triggers.0.alertThreshold": cty.GetAttrPath("trigger").IndexInt(0).GetAttr("alert_threshold"),
+		"trigger.1.alertThreshold":  cty.GetAttrPath("trigger").IndexInt(1).GetAttr("alert_threshold"),
+		"trigger.1.unknownField":    cty.GetAttrPath("trigger").IndexInt(1),
+		"nonFieldErrors":            nil,
+	} {
+		if got := attributePathFromField(ty, field); !got.Equals(expected) {
+			t.Errorf("attributePathFromField(%q) = %#v, want %#v", field, got, expected)
+		}
+	}
+}
+
+func TestDiagFromErr(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceSentryTeam().Schema, map[string]interface{}{
+		"organization": "my-org",
+		"name":         "My Team",
+	})
+
+	diags := diagFromErr(&sentry.ValidationError{
+		ErrorResponse: &sentry.ErrorResponse{},
+		FieldErrors: map[string][]string{
+			"slug":           {"Enter a valid slug."},
+			"nonFieldErrors": {"Something went wrong."},
+		},
+	}, d)
+	if len(diags) != 2 {
+		t.Fatalf("expected 2 diagnostics, got %d", len(diags))
+	}
+	if diags[0].Summary != "Invalid nonFieldErrors: Something went wrong." || diags[0].AttributePath != nil {
+		t.Errorf("unexpected diagnostic %#v", diags[0])
+	}
+	if diags[1].Summary != "Invalid slug: Enter a valid slug." || !diags[1].AttributePath.Equals(cty.GetAttrPath("slug")) {
+		t.Errorf("unexpected diagnostic %#v", diags[1])
+	}
+
+	diags = diagFromErr(errors.New("boom"), d)
+	if len(diags) != 1 || diags[0].Summary != "boom" || diags[0].AttributePath != nil {
+		t.Errorf("unexpected diagnostics %#v", diags)
+	}
+}
@@ -2,10 +2,11 @@ package sentry
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
-	"net/http"
 	"reflect"
 	"strings"
+	"time"
 
 	"github.com/getkevin/terraform-provider-sentry/sentry/lib"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -54,6 +55,28 @@ func SuppressEquivalentJSONDiffs(k, old, new string, d *schema.ResourceData) boo
 	return reflect.DeepEqual(o, n)
 }
 
+// suppressEquivalentTimeDiffs ignores differences between RFC 3339 timestamps
+// that refer to the same instant, e.g. different time zones or precision.
+func suppressEquivalentTimeDiffs(k, old, new string, d *schema.ResourceData) bool {
+	o, err := time.Parse(time.RFC3339, old)
+	if err != nil {
+		return false
+	}
+
+	n, err := time.Parse(time.RFC3339, new)
+	if err != nil {
+		return false
+	}
+
+	return o.Equal(n)
+}
+
+// suppressSurroundingWhitespaceDiffs ignores differences in leading and
+// trailing whitespace, e.g. the trailing newline of a heredoc.
+func suppressSurroundingWhitespaceDiffs(k, old, new string, d *schema.ResourceData) bool {
+	return strings.TrimSpace(old) == strings.TrimSpace(new)
+}
+
 // followShape reshapes the value into the provided shape
 func followShape(shape, value interface{}) interface{} {
 	switch shape := shape.(type) {
@@ -92,6 +115,24 @@ func flattenStringSet(strings []string) *schema.Set {
 	return flattenedStrings
 }
 
+func flattenTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format(time.RFC3339Nano)
+}
+
+func expandTime(v string) (*time.Time, error) {
+	if v == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, v)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
 func expandStringList(configured []interface{}) []string {
 	vs := make([]string, 0, len(configured))
 	for _, v := range configured {
@@ -107,9 +148,9 @@ func expandStringList(configured []interface{}) []string {
 // `true`, `nil` => a resource was successfully found
 // `false`, `nil` => a resource was successfully not found
 // `false`, `err` => encountered an unexpected error
-func checkClientGet(resp *sentry.Response, err error, d *schema.ResourceData) (bool, error) {
+func checkClientGet(err error, d *schema.ResourceData) (bool, error) {
 	if err != nil {
-		if resp != nil && resp.Response.StatusCode == http.StatusNotFound {
+		if isNotFound(err) {
 			d.SetId("")
 			return false, nil
 		}
@@ -119,3 +160,64 @@ func checkClientGet(resp *sentry.Response, err error, d *schema.ResourceData) (b
 
 	return true, nil
 }
+
+func isNotFound(err error) bool {
+	var notFoundErr *sentry.NotFoundError
+	return errors.As(err, &notFoundErr)
+}
+
+// defaultTimeout is the default timeout of resource operations. It matches
+// the default of the SDK.
+const defaultTimeout = 20 * time.Minute
+
+// setDefaultTimeouts declares a timeout for each operation of the resource
+// which doesn't have one, so that all timeouts can be configured in a
+// timeouts block. The SDK cancels the context of an operation, and with it
+// all requests to Sentry, once its timeout has passed.
+func setDefaultTimeouts(r *schema.Resource) {
+	if r.Timeouts == nil {
+		r.Timeouts = &schema.ResourceTimeout{}
+	}
+	if r.CreateContext != nil && r.Timeouts.Create == nil {
+		r.Timeouts.Create = schema.DefaultTimeout(defaultTimeout)
+	}
+	if r.ReadContext != nil && r.Timeouts.Read == nil {
+		r.Timeouts.Read = schema.DefaultTimeout(defaultTimeout)
+	}
+	if r.UpdateContext != nil && r.Timeouts.Update == nil {
+		r.Timeouts.Update = schema.DefaultTimeout(defaultTimeout)
+	}
+	if r.DeleteContext != nil && r.Timeouts.Delete == nil {
+		r.Timeouts.Delete = schema.DefaultTimeout(defaultTimeout)
+	}
+}
+
+// alertTimeouts returns the timeouts of alert resources. Creating or updating
+// an alert may wait for an async task in Sentry until the timeout has passed,
+// unless the provider's alert_task_timeout is shorter.
+func alertTimeouts() *schema.ResourceTimeout {
+	return &schema.ResourceTimeout{
+		Create: schema.DefaultTimeout(5 * time.Minute),
+		Update: schema.DefaultTimeout(5 * time.Minute),
+	}
+}
+
+// mergeSchemas returns a schema containing the attributes of all schemas.
+func mergeSchemas(schemas ...map[string]*schema.Schema) map[string]*schema.Schema {
+	merged := make(map[string]*schema.Schema)
+	for _, s := range schemas {
+		for k, v := range s {
+			merged[k] = v
+		}
+	}
+	return merged
+}
+
+func containsString(s []string, v string) bool {
+	for _, e := range s {
+		if e == v {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,626 @@
+package sentry
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// issueAlertField describes a field of an issue alert condition, filter or
+// action, e.g. the interval of an event frequency condition.
+type issueAlertField struct {
+	// Attribute is the name of the Terraform attribute.
+	Attribute string
+	// Key is the key of the field in the Sentry API.
+	Key         string
+	Type        schema.ValueType
+	Required    bool
+	Description string
+	Validate    schema.SchemaValidateFunc
+	// Default is the value Sentry assumes when the field is not sent.
+	Default interface{}
+}
+
+// issueAlertRegistryEntry describes an entry of Sentry's rules registry which
+// can be configured as a typed block.
+// https://github.com/getsentry/sentry/tree/master/src/sentry/rules
+type issueAlertRegistryEntry struct {
+	// Block is the name of the Terraform block.
+	Block string
+	// ID is the ID of the entry in the Sentry API.
+	ID          string
+	Description string
+	Fields      []issueAlertField
+}
+
+var (
+	issueAlertFrequencyIntervals = []string{"1m", "5m", "15m", "1h", "1d", "1w", "30d"}
+	issueAlertMatchTypes         = []string{"eq", "ne", "sw", "ew", "co", "nc", "is", "ns"}
+)
+
+func issueAlertFrequencyFields(valueType schema.ValueType, intervals []string) []issueAlertField {
+	return []issueAlertField{
+		{
+			Attribute:   "comparison_type",
+			Key:         "comparisonType",
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Compare the absolute number of events (`count`) or the change relative to an earlier interval (`percent`).",
+			Validate:    validation.StringInSlice([]string{"count", "percent"}, false),
+			Default:     "count",
+		},
+		{
+			Attribute:   "value",
+			Key:         "value",
+			Type:        valueType,
+			Required:    true,
+			Description: "The threshold the condition triggers at.",
+		},
+		{
+			Attribute:   "interval",
+			Key:         "interval",
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The interval events are counted in. One of `" + strings.Join(intervals, "`, `") + "`.",
+			Validate:    validation.StringInSlice(intervals, false),
+		},
+		{
+			Attribute:   "comparison_interval",
+			Key:         "comparisonInterval",
+			Type:        schema.TypeString,
+			Description: "The earlier interval to compare to when `comparison_type` is `percent`, e.g. `1w`.",
+			Validate:    validation.StringInSlice([]string{"5m", "15m", "1h", "1d", "1w", "30d"}, false),
+		},
+	}
+}
+
+var issueAlertConditionRegistry = []issueAlertRegistryEntry{
+	{
+		Block:       "first_seen_event",
+		ID:          "sentry.rules.conditions.first_seen_event.FirstSeenEventCondition",
+		Description: "A new issue is created.",
+	},
+	{
+		Block:       "regression_event",
+		ID:          "sentry.rules.conditions.regression_event.RegressionEventCondition",
+		Description: "The issue changes state from resolved to unresolved.",
+	},
+	{
+		Block:       "reappeared_event",
+		ID:          "sentry.rules.conditions.reappeared_event.ReappearedEventCondition",
+		Description: "The issue changes state from ignored to unresolved.",
+	},
+	{
+		Block:       "event_frequency",
+		ID:          "sentry.rules.conditions.event_frequency.EventFrequencyCondition",
+		Description: "The issue is seen more than `value` times in `interval`.",
+		Fields:      issueAlertFrequencyFields(schema.TypeInt, issueAlertFrequencyIntervals),
+	},
+	{
+		Block:       "event_unique_user_frequency",
+		ID:          "sentry.rules.conditions.event_frequency.EventUniqueUserFrequencyCondition",
+		Description: "The issue is seen by more than `value` users in `interval`.",
+		Fields:      issueAlertFrequencyFields(schema.TypeInt, issueAlertFrequencyIntervals),
+	},
+	{
+		Block:       "event_frequency_percent",
+		ID:          "sentry.rules.conditions.event_frequency.EventFrequencyPercentCondition",
+		Description: "The number of events of the issue is more than `value` percent of the sessions in `interval`.",
+		Fields:      issueAlertFrequencyFields(schema.TypeFloat, []string{"5m", "10m", "30m", "1h"}),
+	},
+}
+
+var issueAlertFilterRegistry = []issueAlertRegistryEntry{
+	{
+		Block:       "age_comparison",
+		ID:          "sentry.rules.filters.age_comparison.AgeComparisonFilter",
+		Description: "The issue is older or newer than `value` `time`.",
+		Fields: []issueAlertField{
+			{
+				Attribute:   "comparison_type",
+				Key:         "comparison_type",
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "One of `older` or `newer`.",
+				Validate:    validation.StringInSlice([]string{"older", "newer"}, false),
+			},
+			{
+				Attribute: "value",
+				Key:       "value",
+				Type:      schema.TypeInt,
+				Required:  true,
+			},
+			{
+				Attribute:   "time",
+				Key:         "time",
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "One of `minute`, `hour`, `day` or `week`.",
+				Validate:    validation.StringInSlice([]string{"minute", "hour", "day", "week"}, false),
+			},
+		},
+	},
+	{
+		Block:       "issue_occurrences",
+		ID:          "sentry.rules.filters.issue_occurrences.IssueOccurrencesFilter",
+		Description: "The issue has happened at least `value` times.",
+		Fields: []issueAlertField{
+			{
+				Attribute: "value",
+				Key:       "value",
+				Type:      schema.TypeInt,
+				Required:  true,
+			},
+		},
+	},
+	{
+		Block:       "assigned_to",
+		ID:          "sentry.rules.filters.assigned_to.AssignedToFilter",
+		Description: "The issue is assigned to no one, a team or a member.",
+		Fields: []issueAlertField{
+			{
+				Attribute:   "target_type",
+				Key:         "targetType",
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "One of `Unassigned`, `Team` or `Member`.",
+				Validate:    validation.StringInSlice([]string{"Unassigned", "Team", "Member"}, false),
+			},
+			{
+				Attribute:   "target_identifier",
+				Key:         "targetIdentifier",
+				Type:        schema.TypeString,
+				Description: "The ID of the team or member.",
+			},
+		},
+	},
+	{
+		Block:       "latest_release",
+		ID:          "sentry.rules.filters.latest_release.LatestReleaseFilter",
+		Description: "The event is from the latest release.",
+	},
+	{
+		Block:       "event_attribute",
+		ID:          "sentry.rules.filters.event_attribute.EventAttributeFilter",
+		Description: "The event's `attribute` matches `value`.",
+		Fields: []issueAlertField{
+			{
+				Attribute:   "attribute",
+				Key:         "attribute",
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The event attribute, e.g. `message` or `exception.type`.",
+			},
+			{
+				Attribute:   "match",
+				Key:         "match",
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "One of `" + strings.Join(issueAlertMatchTypes, "`, `") + "`.",
+				Validate:    validation.StringInSlice(issueAlertMatchTypes, false),
+			},
+			{
+				Attribute: "value",
+				Key:       "value",
+				Type:      schema.TypeString,
+			},
+		},
+	},
+	{
+		Block:       "tagged_event",
+		ID:          "sentry.rules.filters.tagged_event.TaggedEventFilter",
+		Description: "The event's tag `key` matches `value`.",
+		Fields: []issueAlertField{
+			{
+				Attribute: "key",
+				Key:       "key",
+				Type:      schema.TypeString,
+				Required:  true,
+			},
+			{
+				Attribute:   "match",
+				Key:         "match",
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "One of `" + strings.Join(issueAlertMatchTypes, "`, `") + "`.",
+				Validate:    validation.StringInSlice(issueAlertMatchTypes, false),
+			},
+			{
+				Attribute: "value",
+				Key:       "value",
+				Type:      schema.TypeString,
+			},
+		},
+	},
+	{
+		Block:       "level",
+		ID:          "sentry.rules.filters.level.LevelFilter",
+		Description: "The event's level compares to `level`.",
+		Fields: []issueAlertField{
+			{
+				Attribute:   "match",
+				Key:         "match",
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "One of `eq`, `gte` or `lte`.",
+				Validate:    validation.StringInSlice([]string{"eq", "gte", "lte"}, false),
+			},
+			{
+				Attribute: "level",
+				Key:       "level",
+				Type:      schema.TypeString,
+				Required:  true,
+				Description: "The numeric level: `50` (fatal), `40` (error), `30` (warning), `20` (info), " +
+					"`10` (debug) or `0` (sample).",
+				Validate: validation.StringInSlice([]string{"50", "40", "30", "20", "10", "0"}, false),
+			},
+		},
+	},
+}
+
+var issueAlertActionRegistry = []issueAlertRegistryEntry{
+	{
+		Block:       "mail_action",
+		ID:          "sentry.mail.actions.NotifyEmailAction",
+		Description: "Send an email to the issue owners, a team or a member.",
+		Fields: []issueAlertField{
+			{
+				Attribute:   "target_type",
+				Key:         "targetType",
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "One of `IssueOwners`, `Team` or `Member`.",
+				Validate:    validation.StringInSlice([]string{"IssueOwners", "Team", "Member"}, false),
+			},
+			{
+				Attribute:   "target_identifier",
+				Key:         "targetIdentifier",
+				Type:        schema.TypeString,
+				Description: "The ID of the team or member.",
+			},
+			{
+				Attribute:   "fallthrough_type",
+				Key:         "fallthroughType",
+				Type:        schema.TypeString,
+				Description: "Who to notify if there are no issue owners. One of `ActiveMembers`, `AllMembers` or `NoOne`.",
+				Validate:    validation.StringInSlice([]string{"ActiveMembers", "AllMembers", "NoOne"}, false),
+				Default:     "ActiveMembers",
+			},
+		},
+	},
+	{
+		Block:       "notify_event",
+		ID:          "sentry.rules.actions.notify_event.NotifyEventAction",
+		Description: "Send a notification to all legacy integrations.",
+	},
+	{
+		Block:       "notify_event_service",
+		ID:          "sentry.rules.actions.notify_event_service.NotifyEventServiceAction",
+		Description: "Send a notification via an integration or a plugin.",
+		Fields: []issueAlertField{
+			{
+				Attribute:   "service",
+				Key:         "service",
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The slug of the service, e.g. `mail` or the slug of a Sentry app.",
+			},
+		},
+	},
+	{
+		Block:       "slack_notify_service",
+		ID:          "sentry.integrations.slack.notify_action.SlackNotifyServiceAction",
+		Description: "Send a notification to a Slack channel.",
+		Fields: []issueAlertField{
+			{
+				Attribute:   "workspace",
+				Key:         "workspace",
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of the Slack integration.",
+			},
+			{
+				Attribute:   "channel",
+				Key:         "channel",
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the channel, e.g. `#alerts`.",
+			},
+			{
+				Attribute:   "channel_id",
+				Key:         "channel_id",
+				Type:        schema.TypeString,
+				Description: "The ID of the channel. Looked up by Sentry if not set.",
+			},
+			{
+				Attribute:   "tags",
+				Key:         "tags",
+				Type:        schema.TypeString,
+				Description: "A comma separated list of tags to show in the notification.",
+			},
+			{
+				Attribute:   "notes",
+				Key:         "notes",
+				Type:        schema.TypeString,
+				Description: "Notes to show in the notification.",
+			},
+		},
+	},
+	{
+		Block:       "pagerduty_notify_service",
+		ID:          "sentry.integrations.pagerduty.notify_action.PagerDutyNotifyServiceAction",
+		Description: "Send a notification to a PagerDuty service.",
+		Fields: []issueAlertField{
+			{
+				Attribute:   "account",
+				Key:         "account",
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of the PagerDuty integration.",
+			},
+			{
+				Attribute:   "service",
+				Key:         "service",
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of the PagerDuty service.",
+			},
+			{
+				Attribute:   "severity",
+				Key:         "severity",
+				Type:        schema.TypeString,
+				Description: "One of `default`, `critical`, `warning`, `error` or `info`.",
+				Validate:    validation.StringInSlice([]string{"default", "critical", "warning", "error", "info"}, false),
+				Default:     "default",
+			},
+		},
+	},
+}
+
+// issueAlertBlockSchema returns the schema of a list of typed blocks, each of
+// which configures exactly one entry of the registry.
+func issueAlertBlockSchema(description string, registry []issueAlertRegistryEntry) *schema.Schema {
+	kinds := make(map[string]*schema.Schema, len(registry))
+	for _, entry := range registry {
+		fields := make(map[string]*schema.Schema, len(entry.Fields))
+		for _, field := range entry.Fields {
+			s := &schema.Schema{
+				Description:  field.Description,
+				Type:         field.Type,
+				Required:     field.Required,
+				Optional:     !field.Required,
+				ValidateFunc: field.Validate,
+			}
+			if !field.Required && field.Default != nil {
+				s.Description += fmt.Sprintf(" Defaults to `%v`.", field.Default)
+				s.Default = field.Default
+			}
+			fields[field.Attribute] = s
+		}
+		kinds[entry.Block] = &schema.Schema{
+			Description: entry.Description,
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: fields,
+			},
+		}
+	}
+
+	return &schema.Schema{
+		Description: description + " Each block configures exactly one of `" +
+			strings.Join(issueAlertRegistryBlocks(registry), "`, `") + "`.",
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: kinds,
+		},
+	}
+}
+
+func issueAlertRegistryBlocks(registry []issueAlertRegistryEntry) []string {
+	blocks := make([]string, 0, len(registry))
+	for _, entry := range registry {
+		blocks = append(blocks, entry.Block)
+	}
+	sort.Strings(blocks)
+	return blocks
+}
+
+// validateIssueAlertBlocks checks that each typed block configures exactly
+// one entry of the registry.
+func validateIssueAlertBlocks(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	for _, b := range []struct {
+		attribute string
+		registry  []issueAlertRegistryEntry
+	}{
+		{"condition", issueAlertConditionRegistry},
+		{"filter", issueAlertFilterRegistry},
+		{"action", issueAlertActionRegistry},
+	} {
+		for i, v := range d.Get(b.attribute).([]interface{}) {
+			var configured []string
+			if m, ok := v.(map[string]interface{}); ok {
+				for _, entry := range b.registry {
+					if l, ok := m[entry.Block].([]interface{}); ok && len(l) > 0 {
+						configured = append(configured, entry.Block)
+					}
+				}
+			}
+			if len(configured) != 1 {
+				return fmt.Errorf("%s.%d: exactly one of `%s` must be configured, got %d",
+					b.attribute, i, strings.Join(issueAlertRegistryBlocks(b.registry), "`, `"), len(configured))
+			}
+		}
+	}
+	return nil
+}
+
+// expandIssueAlertBlocks converts typed blocks to the objects of the Sentry
+// API. Optional fields without a default are only sent when they are set in
+// config, the raw configuration of the blocks. Without a known config, only
+// non-zero values are sent.
+func expandIssueAlertBlocks(blocks []interface{}, config cty.Value, registry []issueAlertRegistryEntry) ([]map[string]interface{}, error) {
+	out := make([]map[string]interface{}, 0, len(blocks))
+	for i, v := range blocks {
+		m, _ := v.(map[string]interface{})
+
+		var obj map[string]interface{}
+		for _, entry := range registry {
+			l, ok := m[entry.Block].([]interface{})
+			if !ok || len(l) == 0 {
+				continue
+			}
+			if obj != nil {
+				return nil, fmt.Errorf("block %d configures more than one of `%s`", i, strings.Join(issueAlertRegistryBlocks(registry), "`, `"))
+			}
+
+			fields, _ := l[0].(map[string]interface{})
+			fieldsConfig := issueAlertBlockFieldsConfig(config, i, entry.Block)
+			obj = map[string]interface{}{"id": entry.ID}
+			for _, field := range entry.Fields {
+				value, ok := fields[field.Attribute]
+				if !ok {
+					continue
+				}
+				if !field.Required && field.Default == nil {
+					set := value != schemaZeroValue(field.Type)
+					if fieldsConfig.Type().HasAttribute(field.Attribute) {
+						set = !fieldsConfig.GetAttr(field.Attribute).IsNull()
+					}
+					if !set {
+						continue
+					}
+				}
+				obj[field.Key] = value
+			}
+		}
+		if obj == nil {
+			return nil, fmt.Errorf("block %d configures none of `%s`", i, strings.Join(issueAlertRegistryBlocks(registry), "`, `"))
+		}
+		out = append(out, obj)
+	}
+	return out, nil
+}
+
+// issueAlertBlockFieldsConfig returns the configuration of the fields of the
+// given block of the i-th typed block, or an empty object if it is not known.
+func issueAlertBlockFieldsConfig(config cty.Value, i int, block string) cty.Value {
+	empty := cty.EmptyObjectVal
+	if !config.IsKnown() || config.IsNull() || !config.Type().IsListType() || config.LengthInt() <= i {
+		return empty
+	}
+	v := config.Index(cty.NumberIntVal(int64(i)))
+	if !v.IsKnown() || v.IsNull() || !v.Type().IsObjectType() || !v.Type().HasAttribute(block) {
+		return empty
+	}
+	l := v.GetAttr(block)
+	if !l.IsKnown() || l.IsNull() || !l.Type().IsListType() || l.LengthInt() == 0 {
+		return empty
+	}
+	fields := l.Index(cty.NumberIntVal(0))
+	if !fields.IsKnown() || fields.IsNull() || !fields.Type().IsObjectType() {
+		return empty
+	}
+	return fields
+}
+
+// flattenIssueAlertBlocks converts objects of the Sentry API to typed blocks.
+// Objects which are not in the registry are returned separately, so that they
+// can be kept as raw objects and the difference to the configuration shows up
+// in the plan.
+func flattenIssueAlertBlocks(objects []interface{}, registry []issueAlertRegistryEntry) (blocks, unknown []interface{}) {
+	byID := make(map[string]issueAlertRegistryEntry, len(registry))
+	for _, entry := range registry {
+		byID[entry.ID] = entry
+	}
+
+	blocks = make([]interface{}, 0, len(objects))
+	for _, v := range objects {
+		obj, _ := v.(map[string]interface{})
+		id, _ := obj["id"].(string)
+		entry, ok := byID[id]
+		if !ok {
+			unknown = append(unknown, v)
+			continue
+		}
+
+		fields := make(map[string]interface{}, len(entry.Fields))
+		for _, field := range entry.Fields {
+			if value, ok := convertIssueAlertValue(obj[field.Key], field.Type); ok {
+				fields[field.Attribute] = value
+			}
+		}
+		blocks = append(blocks, map[string]interface{}{
+			entry.Block: []interface{}{fields},
+		})
+	}
+	return blocks, unknown
+}
+
+// convertIssueAlertValue converts a value of the Sentry API to the type of an
+// attribute. Sentry returns values the way they were sent, so numbers may be
+// strings and vice versa.
+func convertIssueAlertValue(v interface{}, t schema.ValueType) (interface{}, bool) {
+	if v == nil {
+		return nil, false
+	}
+
+	var s string
+	switch v := v.(type) {
+	case string:
+		s = v
+	case json.Number:
+		s = v.String()
+	case bool:
+		s = strconv.FormatBool(v)
+	default:
+		s = fmt.Sprint(v)
+	}
+
+	switch t {
+	case schema.TypeInt:
+		if i, err := strconv.Atoi(s); err == nil {
+			return i, true
+		}
+		if f, err := strconv.ParseFloat(s, 64); err == nil {
+			return int(f), true
+		}
+		return nil, false
+	case schema.TypeFloat:
+		f, err := strconv.ParseFloat(s, 64)
+		return f, err == nil
+	case schema.TypeBool:
+		b, err := strconv.ParseBool(s)
+		return b, err == nil
+	default:
+		return s, true
+	}
+}
+
+// withConflicts sets the attributes the schema conflicts with.
+func withConflicts(s *schema.Schema, conflictsWith ...string) *schema.Schema {
+	s.ConflictsWith = conflictsWith
+	return s
+}
+
+func schemaZeroValue(t schema.ValueType) interface{} {
+	switch t {
+	case schema.TypeInt:
+		return 0
+	case schema.TypeFloat:
+		return 0.0
+	case schema.TypeBool:
+		return false
+	default:
+		return ""
+	}
+}
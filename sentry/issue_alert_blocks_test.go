@@ -0,0 +1,180 @@
+package sentry
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestExpandIssueAlertBlocks(t *testing.T) {
+	blocks := []interface{}{
+		map[string]interface{}{
+			"first_seen_event": []interface{}{nil},
+			"event_frequency":  []interface{}{},
+		},
+		map[string]interface{}{
+			"first_seen_event": []interface{}{},
+			"event_frequency": []interface{}{map[string]interface{}{
+				"comparison_type":     "count",
+				"value":               100,
+				"interval":            "1h",
+				"comparison_interval": "",
+			}},
+		},
+	}
+
+	got, err := expandIssueAlertBlocks(blocks, cty.NullVal(cty.DynamicPseudoType), issueAlertConditionRegistry)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []map[string]interface{}{
+		{
+			"id": "sentry.rules.conditions.first_seen_event.FirstSeenEventCondition",
+		},
+		{
+			"id":             "sentry.rules.conditions.event_frequency.EventFrequencyCondition",
+			"comparisonType": "count",
+			"value":          100,
+			"interval":       "1h",
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expandIssueAlertBlocks() = %#v, want %#v", got, want)
+	}
+}
+
+func TestExpandIssueAlertBlocks_config(t *testing.T) {
+	blocks := []interface{}{
+		map[string]interface{}{
+			"tagged_event": []interface{}{map[string]interface{}{
+				"key":   "environment",
+				"match": "eq",
+				"value": "",
+			}},
+		},
+		map[string]interface{}{
+			"tagged_event": []interface{}{map[string]interface{}{
+				"key":   "environment",
+				"match": "is",
+				"value": "",
+			}},
+		},
+	}
+	taggedEvent := func(match string, value cty.Value) cty.Value {
+		return cty.ObjectVal(map[string]cty.Value{
+			"tagged_event": cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+				"key":   cty.StringVal("environment"),
+				"match": cty.StringVal(match),
+				"value": value,
+			})}),
+		})
+	}
+	config := cty.ListVal([]cty.Value{
+		taggedEvent("eq", cty.StringVal("")),
+		taggedEvent("is", cty.NullVal(cty.String)),
+	})
+
+	got, err := expandIssueAlertBlocks(blocks, config, issueAlertFilterRegistry)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []map[string]interface{}{
+		{
+			"id":    "sentry.rules.filters.tagged_event.TaggedEventFilter",
+			"key":   "environment",
+			"match": "eq",
+			"value": "",
+		},
+		{
+			"id":    "sentry.rules.filters.tagged_event.TaggedEventFilter",
+			"key":   "environment",
+			"match": "is",
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expandIssueAlertBlocks() = %#v, want %#v", got, want)
+	}
+}
+
+func TestExpandIssueAlertBlocks_invalid(t *testing.T) {
+	for name, block := range map[string]interface{}{
+		"none": map[string]interface{}{},
+		"many": map[string]interface{}{
+			"first_seen_event": []interface{}{nil},
+			"regression_event": []interface{}{nil},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			if _, err := expandIssueAlertBlocks([]interface{}{block}, cty.NullVal(cty.DynamicPseudoType), issueAlertConditionRegistry); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
+
+func TestFlattenIssueAlertBlocks(t *testing.T) {
+	objects := []interface{}{
+		map[string]interface{}{
+			"id":   "sentry.rules.actions.notify_event.NotifyEventAction",
+			"name": "Send a notification (for all legacy integrations)",
+		},
+		map[string]interface{}{
+			"id":         "sentry.integrations.slack.notify_action.SlackNotifyServiceAction",
+			"workspace":  json.Number("123"),
+			"channel":    "#alerts",
+			"channel_id": "C0123",
+			"tags":       "environment,level",
+		},
+		map[string]interface{}{
+			"id": "sentry.rules.actions.unknown.UnknownAction",
+		},
+	}
+
+	got, gotUnknown := flattenIssueAlertBlocks(objects, issueAlertActionRegistry)
+	want := []interface{}{
+		map[string]interface{}{
+			"notify_event": []interface{}{map[string]interface{}{}},
+		},
+		map[string]interface{}{
+			"slack_notify_service": []interface{}{map[string]interface{}{
+				"workspace":  "123",
+				"channel":    "#alerts",
+				"channel_id": "C0123",
+				"tags":       "environment,level",
+			}},
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("flattenIssueAlertBlocks() = %#v, want %#v", got, want)
+	}
+	if wantUnknown := objects[2:]; !reflect.DeepEqual(gotUnknown, wantUnknown) {
+		t.Errorf("flattenIssueAlertBlocks() unknown = %#v, want %#v", gotUnknown, wantUnknown)
+	}
+}
+
+func TestConvertIssueAlertValue(t *testing.T) {
+	testCases := []struct {
+		value  interface{}
+		typ    schema.ValueType
+		want   interface{}
+		wantOk bool
+	}{
+		{json.Number("100"), schema.TypeInt, 100, true},
+		{"100", schema.TypeInt, 100, true},
+		{float64(100), schema.TypeInt, 100, true},
+		{"50.0", schema.TypeFloat, 50.0, true},
+		{json.Number("50.5"), schema.TypeFloat, 50.5, true},
+		{json.Number("123"), schema.TypeString, "123", true},
+		{"abc", schema.TypeInt, nil, false},
+		{nil, schema.TypeString, nil, false},
+	}
+	for _, tc := range testCases {
+		got, ok := convertIssueAlertValue(tc.value, tc.typ)
+		if ok != tc.wantOk || (ok && got != tc.want) {
+			t.Errorf("convertIssueAlertValue(%#v, %v) = %#v, %t; want %#v, %t", tc.value, tc.typ, got, ok, tc.want, tc.wantOk)
+		}
+	}
+}
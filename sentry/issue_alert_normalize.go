@@ -0,0 +1,227 @@
+package sentry
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// issueAlertRegistryEntryByID returns the registry entry of a condition,
+// filter or action ID.
+func issueAlertRegistryEntryByID(id string) (issueAlertRegistryEntry, bool) {
+	for _, registry := range [][]issueAlertRegistryEntry{
+		issueAlertConditionRegistry,
+		issueAlertFilterRegistry,
+		issueAlertActionRegistry,
+	} {
+		for _, entry := range registry {
+			if entry.ID == id {
+				return entry, true
+			}
+		}
+	}
+	return issueAlertRegistryEntry{}, false
+}
+
+// normalizeIssueAlertObject returns the canonical form of a condition, filter
+// or action. Values are converted to the type of their field, so that e.g.
+// "10" and 10 or "True" and true are equal, and fields which are unset or set
+// to their default are dropped.
+func normalizeIssueAlertObject(obj map[string]interface{}) map[string]string {
+	id, _ := obj["id"].(string)
+	entry, _ := issueAlertRegistryEntryByID(id)
+
+	types := make(map[string]schema.ValueType, len(entry.Fields))
+	defaults := make(map[string]string, len(entry.Fields))
+	for _, field := range entry.Fields {
+		types[field.Key] = field.Type
+		if field.Default != nil {
+			defaults[field.Key] = canonicalIssueAlertValue(field.Default, field.Type)
+		}
+	}
+
+	out := make(map[string]string, len(obj))
+	for k, v := range obj {
+		if v == nil {
+			continue
+		}
+		value := canonicalIssueAlertValue(v, types[k])
+		if d, ok := defaults[k]; ok && d == value {
+			continue
+		}
+		out[k] = value
+	}
+	return out
+}
+
+// canonicalIssueAlertValue returns the canonical string form of a value. The
+// type of values of unknown fields is guessed from the value.
+func canonicalIssueAlertValue(v interface{}, t schema.ValueType) string {
+	if t == schema.TypeInvalid {
+		s := issueAlertValueString(v)
+		switch {
+		case isNumber(s):
+			t = schema.TypeFloat
+		case strings.EqualFold(s, "true") || strings.EqualFold(s, "false"):
+			t = schema.TypeBool
+		default:
+			t = schema.TypeString
+		}
+	}
+
+	converted, ok := convertIssueAlertValue(v, t)
+	if !ok {
+		return issueAlertValueString(v)
+	}
+	switch converted := converted.(type) {
+	case int:
+		return strconv.Itoa(converted)
+	case float64:
+		return strconv.FormatFloat(converted, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(converted)
+	default:
+		return fmt.Sprint(converted)
+	}
+}
+
+func isNumber(s string) bool {
+	_, err := strconv.ParseFloat(s, 64)
+	return err == nil
+}
+
+// issueAlertValueString converts a value of the Sentry API to a string.
+// Values which are not scalars are encoded as JSON.
+func issueAlertValueString(v interface{}) string {
+	switch v := v.(type) {
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	case bool:
+		return strconv.FormatBool(v)
+	default:
+		b, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprint(v)
+		}
+		return string(b)
+	}
+}
+
+// issueAlertObjectKey returns a key which is equal for semantically equal
+// objects.
+func issueAlertObjectKey(v interface{}) string {
+	obj, _ := v.(map[string]interface{})
+	b, _ := json.Marshal(normalizeIssueAlertObject(obj))
+	return string(b)
+}
+
+// issueAlertObjectsEqual reports whether two lists of conditions, filters or
+// actions are semantically equal. Sentry evaluates them regardless of their
+// order, so the order is ignored.
+func issueAlertObjectsEqual(a, b []interface{}) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	keys := func(objects []interface{}) []string {
+		out := make([]string, 0, len(objects))
+		for _, obj := range objects {
+			out = append(out, issueAlertObjectKey(obj))
+		}
+		sort.Strings(out)
+		return out
+	}
+	ka, kb := keys(a), keys(b)
+	for i := range ka {
+		if ka[i] != kb[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// shapeIssueAlertObjects reshapes the objects returned by Sentry into the
+// shape of the objects in the state. Objects are matched by content rather
+// than position, since Sentry may reorder them. Objects without a counterpart
+// in the state are appended.
+func shapeIssueAlertObjects(shape, objects []interface{}) []interface{} {
+	used := make([]bool, len(objects))
+	match := func(s interface{}, equal func(shaped interface{}) bool) (interface{}, bool) {
+		for i, obj := range objects {
+			if used[i] {
+				continue
+			}
+			if shaped := followShape(s, obj); equal(shaped) {
+				used[i] = true
+				return shaped, true
+			}
+		}
+		return nil, false
+	}
+
+	out := make([]interface{}, 0, len(objects))
+	for _, s := range shape {
+		key := issueAlertObjectKey(s)
+		shaped, ok := match(s, func(shaped interface{}) bool {
+			return issueAlertObjectKey(shaped) == key
+		})
+		if !ok {
+			id := issueAlertObjectID(s)
+			shaped, ok = match(s, func(shaped interface{}) bool {
+				return issueAlertObjectID(shaped) == id
+			})
+		}
+		if ok {
+			out = append(out, stringifyIssueAlertObject(shaped))
+		}
+	}
+	for i, obj := range objects {
+		if !used[i] {
+			out = append(out, stringifyIssueAlertObject(obj))
+		}
+	}
+	return out
+}
+
+func issueAlertObjectID(v interface{}) string {
+	obj, _ := v.(map[string]interface{})
+	id, _ := obj["id"].(string)
+	return id
+}
+
+// stringifyIssueAlertObject converts the values of an object to strings, as
+// required by the schema.
+func stringifyIssueAlertObject(v interface{}) map[string]interface{} {
+	obj, _ := v.(map[string]interface{})
+	out := make(map[string]interface{}, len(obj))
+	for k, v := range obj {
+		if v == nil {
+			continue
+		}
+		out[k] = issueAlertValueString(v)
+	}
+	return out
+}
+
+// suppressEquivalentIssueAlertObjects suppresses the difference between
+// semantically equal conditions, filters and actions, e.g. when Sentry changed
+// the type of a value or the order of the objects. It is called for every key
+// of the list, so the whole lists are compared.
+func suppressEquivalentIssueAlertObjects(k, old, new string, d *schema.ResourceData) bool {
+	attribute, _, _ := strings.Cut(k, ".")
+	if config := d.GetRawConfig(); !config.IsNull() {
+		// The new value falls back to the state when the list is removed
+		// from the configuration, so removals are never suppressed.
+		if v := config.GetAttr(attribute); v.IsNull() || !v.IsWhollyKnown() {
+			return false
+		}
+	}
+	o, n := d.GetChange(attribute)
+	return issueAlertObjectsEqual(o.([]interface{}), n.([]interface{}))
+}
@@ -0,0 +1,207 @@
+package sentry
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	ctyjson "github.com/hashicorp/go-cty/cty/json"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestNormalizeIssueAlertObject(t *testing.T) {
+	got := normalizeIssueAlertObject(map[string]interface{}{
+		"id":             "sentry.rules.conditions.event_frequency.EventFrequencyCondition",
+		"comparisonType": "count",
+		"value":          "10",
+		"interval":       "1h",
+		"name":           nil,
+	})
+	want := map[string]string{
+		"id":       "sentry.rules.conditions.event_frequency.EventFrequencyCondition",
+		"value":    "10",
+		"interval": "1h",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("normalizeIssueAlertObject() = %#v, want %#v", got, want)
+	}
+}
+
+func TestIssueAlertObjectsEqual(t *testing.T) {
+	cases := map[string]struct {
+		a, b []interface{}
+		want bool
+	}{
+		"number types": {
+			a:    []interface{}{map[string]interface{}{"id": "sentry.rules.filters.issue_occurrences.IssueOccurrencesFilter", "value": "10"}},
+			b:    []interface{}{map[string]interface{}{"id": "sentry.rules.filters.issue_occurrences.IssueOccurrencesFilter", "value": json.Number("10")}},
+			want: true,
+		},
+		"unknown booleans": {
+			a:    []interface{}{map[string]interface{}{"id": "sentry.rules.actions.Unknown", "hasSchemaFormConfig": "True"}},
+			b:    []interface{}{map[string]interface{}{"id": "sentry.rules.actions.Unknown", "hasSchemaFormConfig": "true"}},
+			want: true,
+		},
+		"unknown numbers": {
+			a:    []interface{}{map[string]interface{}{"id": "sentry.rules.actions.Unknown", "value": "50.0"}},
+			b:    []interface{}{map[string]interface{}{"id": "sentry.rules.actions.Unknown", "value": "50"}},
+			want: true,
+		},
+		"defaults": {
+			a:    []interface{}{map[string]interface{}{"id": "sentry.mail.actions.NotifyEmailAction", "targetType": "IssueOwners"}},
+			b:    []interface{}{map[string]interface{}{"id": "sentry.mail.actions.NotifyEmailAction", "targetType": "IssueOwners", "fallthroughType": "ActiveMembers"}},
+			want: true,
+		},
+		"order": {
+			a: []interface{}{
+				map[string]interface{}{"id": "sentry.rules.conditions.first_seen_event.FirstSeenEventCondition"},
+				map[string]interface{}{"id": "sentry.rules.conditions.regression_event.RegressionEventCondition"},
+			},
+			b: []interface{}{
+				map[string]interface{}{"id": "sentry.rules.conditions.regression_event.RegressionEventCondition"},
+				map[string]interface{}{"id": "sentry.rules.conditions.first_seen_event.FirstSeenEventCondition"},
+			},
+			want: true,
+		},
+		"different values": {
+			a:    []interface{}{map[string]interface{}{"id": "sentry.rules.filters.issue_occurrences.IssueOccurrencesFilter", "value": "10"}},
+			b:    []interface{}{map[string]interface{}{"id": "sentry.rules.filters.issue_occurrences.IssueOccurrencesFilter", "value": "11"}},
+			want: false,
+		},
+		"different lengths": {
+			a: []interface{}{map[string]interface{}{"id": "sentry.rules.conditions.first_seen_event.FirstSeenEventCondition"}},
+			b: []interface{}{
+				map[string]interface{}{"id": "sentry.rules.conditions.first_seen_event.FirstSeenEventCondition"},
+				map[string]interface{}{"id": "sentry.rules.conditions.first_seen_event.FirstSeenEventCondition"},
+			},
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := issueAlertObjectsEqual(tc.a, tc.b); got != tc.want {
+				t.Errorf("issueAlertObjectsEqual() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestShapeIssueAlertObjects(t *testing.T) {
+	shape := []interface{}{
+		map[string]interface{}{"id": "sentry.rules.filters.issue_occurrences.IssueOccurrencesFilter", "value": "10"},
+		map[string]interface{}{"id": "sentry.rules.filters.level.LevelFilter", "match": "gte", "level": "40"},
+	}
+	objects := []interface{}{
+		map[string]interface{}{"id": "sentry.rules.filters.level.LevelFilter", "match": "gte", "level": "40", "name": "The event's level is greater than or equal to error"},
+		map[string]interface{}{"id": "sentry.rules.filters.latest_release.LatestReleaseFilter", "name": "The event is from the latest release"},
+		map[string]interface{}{"id": "sentry.rules.filters.issue_occurrences.IssueOccurrencesFilter", "value": json.Number("10"), "name": "The issue has happened at least 10 times"},
+	}
+
+	got := shapeIssueAlertObjects(shape, objects)
+	want := []interface{}{
+		map[string]interface{}{"id": "sentry.rules.filters.issue_occurrences.IssueOccurrencesFilter", "value": "10"},
+		map[string]interface{}{"id": "sentry.rules.filters.level.LevelFilter", "match": "gte", "level": "40"},
+		map[string]interface{}{"id": "sentry.rules.filters.latest_release.LatestReleaseFilter", "name": "The event is from the latest release"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("shapeIssueAlertObjects() = %#v, want %#v", got, want)
+	}
+}
+
+func TestSuppressEquivalentIssueAlertObjects(t *testing.T) {
+	r := resourceSentryIssueAlert()
+
+	stateData := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"organization": "my-org",
+		"project":      "my-project",
+		"projects":     []interface{}{"my-project"},
+		"name":         "My issue alert",
+		"action_match": "any",
+		"filter_match": "any",
+		"frequency":    30,
+		"environment":  "",
+		"conditions": []interface{}{
+			map[string]interface{}{"id": "sentry.rules.conditions.first_seen_event.FirstSeenEventCondition"},
+			map[string]interface{}{"id": "sentry.rules.conditions.event_frequency.EventFrequencyCondition", "value": "10", "comparisonType": "count", "interval": "1h"},
+		},
+		"filters": []interface{}{
+			map[string]interface{}{"id": "sentry.rules.filters.issue_occurrences.IssueOccurrencesFilter", "value": "10"},
+		},
+		"actions": []interface{}{
+			map[string]interface{}{"id": "sentry.mail.actions.NotifyEmailAction", "targetType": "IssueOwners", "fallthroughType": "ActiveMembers"},
+		},
+	})
+	stateData.SetId("my-org/my-project/1")
+
+	diff := func(t *testing.T, config string) *terraform.InstanceDiff {
+		var raw map[string]interface{}
+		if err := json.Unmarshal([]byte(config), &raw); err != nil {
+			t.Fatal(err)
+		}
+		rawConfig, err := ctyjson.Unmarshal([]byte(config), r.CoreConfigSchema().ImpliedType())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		state := stateData.State()
+		state.RawConfig = rawConfig
+		d, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(raw), nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return d
+	}
+
+	t.Run("equivalent objects", func(t *testing.T) {
+		d := diff(t, `{
+			"organization": "my-org",
+			"project": "my-project",
+			"name": "My issue alert",
+			"action_match": "any",
+			"filter_match": "any",
+			"frequency": 30,
+			"conditions": [
+				{"id": "sentry.rules.conditions.event_frequency.EventFrequencyCondition", "value": 10, "interval": "1h"},
+				{"id": "sentry.rules.conditions.first_seen_event.FirstSeenEventCondition"}
+			],
+			"filters": [
+				{"id": "sentry.rules.filters.issue_occurrences.IssueOccurrencesFilter", "value": 10}
+			],
+			"actions": [
+				{"id": "sentry.mail.actions.NotifyEmailAction", "targetType": "IssueOwners"}
+			]
+		}`)
+		if d != nil && len(d.Attributes) > 0 {
+			t.Errorf("expected no diff, got %#v", d.Attributes)
+		}
+	})
+
+	t.Run("removed filters", func(t *testing.T) {
+		d := diff(t, `{
+			"organization": "my-org",
+			"project": "my-project",
+			"name": "My issue alert",
+			"action_match": "any",
+			"filter_match": "any",
+			"frequency": 30,
+			"conditions": [
+				{"id": "sentry.rules.conditions.event_frequency.EventFrequencyCondition", "value": 10, "interval": "1h"},
+				{"id": "sentry.rules.conditions.first_seen_event.FirstSeenEventCondition"}
+			],
+			"actions": [
+				{"id": "sentry.mail.actions.NotifyEmailAction", "targetType": "IssueOwners"}
+			]
+		}`)
+		if d == nil || d.Attributes["filters.#"] == nil || d.Attributes["filters.#"].New != "0" {
+			t.Fatalf("expected filters to be removed, got %#v", d)
+		}
+		for k := range d.Attributes {
+			if !strings.HasPrefix(k, "filters.") {
+				t.Errorf("unexpected diff of %s", k)
+			}
+		}
+	})
+}
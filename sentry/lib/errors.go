@@ -3,6 +3,8 @@ package sentry
 import (
 	"encoding/json"
 	"fmt"
+	"sort"
+	"strings"
 )
 
 // APIError represents a Sentry API Error response.
@@ -48,3 +50,97 @@ func (e APIError) Error() string {
 func (e APIError) Empty() bool {
 	return e.f == nil
 }
+
+// FieldErrors returns the errors of the individual fields of a request, keyed
+// by field name. Errors of nested fields are keyed by their path, e.g.
+// "triggers.0.alertThreshold". Returns nil if the error does not contain
+// field errors.
+func (e APIError) FieldErrors() map[string][]string {
+	v, ok := e.f.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	fieldErrors := make(map[string][]string)
+	for field, value := range v {
+		if field == "detail" {
+			continue
+		}
+		flattenFieldErrors(fieldErrors, field, value)
+	}
+	if len(fieldErrors) == 0 {
+		return nil
+	}
+	return fieldErrors
+}
+
+func flattenFieldErrors(fieldErrors map[string][]string, field string, value interface{}) {
+	switch v := value.(type) {
+	case string:
+		fieldErrors[field] = append(fieldErrors[field], v)
+	case []interface{}:
+		for i, item := range v {
+			if s, ok := item.(string); ok {
+				fieldErrors[field] = append(fieldErrors[field], s)
+			} else {
+				flattenFieldErrors(fieldErrors, fmt.Sprintf("%s.%d", field, i), item)
+			}
+		}
+	case map[string]interface{}:
+		for k, item := range v {
+			flattenFieldErrors(fieldErrors, field+"."+k, item)
+		}
+	case nil:
+	default:
+		fieldErrors[field] = append(fieldErrors[field], fmt.Sprintf("%v", v))
+	}
+}
+
+// NotFoundError is returned when the requested resource does not exist.
+type NotFoundError struct {
+	*ErrorResponse
+}
+
+func (e *NotFoundError) Unwrap() error { return e.ErrorResponse }
+
+// ConflictError is returned when a resource conflicts with an existing one,
+// e.g. when its slug is already taken.
+type ConflictError struct {
+	*ErrorResponse
+}
+
+func (e *ConflictError) Unwrap() error { return e.ErrorResponse }
+
+// PermissionError is returned when the token is not authorized to perform
+// the request.
+type PermissionError struct {
+	*ErrorResponse
+}
+
+func (e *PermissionError) Unwrap() error { return e.ErrorResponse }
+
+// ValidationError is returned when Sentry rejects the parameters of a request.
+type ValidationError struct {
+	*ErrorResponse
+
+	// FieldErrors are the errors of the individual fields, keyed by field name.
+	FieldErrors map[string][]string
+}
+
+func (e *ValidationError) Unwrap() error { return e.ErrorResponse }
+
+// formatFieldErrors formats field errors in a stable order, e.g.
+// "name: This field is required.; slug: Enter a valid slug."
+func formatFieldErrors(fieldErrors map[string][]string) string {
+	fields := make([]string, 0, len(fieldErrors))
+	for field := range fieldErrors {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	parts := make([]string, 0, len(fields))
+	for _, field := range fields {
+		parts = append(parts, fmt.Sprintf("%s: %s", field, strings.Join(fieldErrors[field], " ")))
+	}
+	return strings.Join(parts, "; ")
+}
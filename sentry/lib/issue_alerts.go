@@ -1,7 +1,9 @@
 package sentry
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"time"
@@ -119,7 +121,7 @@ func (s *IssueAlertsService) Create(ctx context.Context, organizationSlug string
 
 // getIssueAlertFromTaskDetail is called when Sentry offloads the issue alert creation process to an async task and sends us back the task's uuid.
 // It usually doesn't happen, but when creating Slack notification rules, it seemed to be sometimes the case. During testing it
-// took very long for a task to finish (10+ seconds) which is why this method can take long to return.
+// took very long for a task to finish (10+ seconds) which is why the task is polled as configured by the client's TaskPolling.
 func (s *IssueAlertsService) getIssueAlertFromTaskDetail(ctx context.Context, organizationSlug string, projectSlug string, taskUUID string) (*IssueAlert, *Response, error) {
 	u := fmt.Sprintf("0/projects/%v/%v/rule-task/%v/", organizationSlug, projectSlug, taskUUID)
 	req, err := s.client.NewRequest("GET", u, nil)
@@ -127,33 +129,41 @@ func (s *IssueAlertsService) getIssueAlertFromTaskDetail(ctx context.Context, or
 		return nil, nil, err
 	}
 
+	var alert *IssueAlert
 	var resp *Response
-	for i := 0; i < 5; i++ {
-		// TODO: Read poll interval from context
-		time.Sleep(5 * time.Second)
-
+	err = s.client.pollTask(ctx, func(ctx context.Context) (bool, error) {
 		taskDetail := new(IssueAlertTaskDetail)
-		resp, err := s.client.Do(ctx, req, taskDetail)
+		resp, err = s.client.Do(ctx, req, taskDetail)
 		if err != nil {
-			return nil, resp, err
+			var notFoundErr *NotFoundError
+			if errors.As(err, &notFoundErr) {
+				return false, fmt.Errorf("cannot find issue alert creation task with UUID %v", taskUUID)
+			}
+			return false, err
 		}
-
-		if resp.StatusCode == 404 {
-			return nil, resp, fmt.Errorf("cannot find issue alert creation task with UUID %v", taskUUID)
+		if taskDetail.Status == nil {
+			return false, nil
 		}
-		if taskDetail.Status != nil && taskDetail.Rule != nil {
-			if *taskDetail.Status == "success" {
-				return taskDetail.Rule, resp, err
-			} else if *taskDetail.Status == "failed" {
-				if taskDetail != nil {
-					return taskDetail.Rule, resp, errors.New(*taskDetail.Error)
-				}
-
-				return taskDetail.Rule, resp, errors.New("error while running the issue alert creation task")
+		switch *taskDetail.Status {
+		case "success":
+			if taskDetail.Rule == nil {
+				return false, nil
+			}
+			alert = taskDetail.Rule
+			return true, nil
+		case "failed":
+			// Failed tasks have no rule, only an error.
+			if taskDetail.Error != nil {
+				return false, errors.New(*taskDetail.Error)
 			}
+			return false, errors.New("error while running the issue alert creation task")
 		}
+		return false, nil
+	})
+	if errors.Is(err, ErrTaskTimeout) || errors.Is(err, context.DeadlineExceeded) {
+		return nil, resp, fmt.Errorf("getting the status of the issue alert creation from Sentry took too long: %w", err)
 	}
-	return nil, resp, errors.New("getting the status of the issue alert creation from Sentry took too long")
+	return alert, resp, err
 }
 
 // Update an issue alert.
@@ -172,6 +182,76 @@ func (s *IssueAlertsService) Update(ctx context.Context, organizationSlug string
 	return alert, resp, nil
 }
 
+// IssueAlertConfiguration lists the conditions, filters and actions available
+// to the issue alerts of a project.
+// https://github.com/getsentry/sentry/blob/23.12.0/src/sentry/api/endpoints/project_rules_configuration.py
+type IssueAlertConfiguration struct {
+	Actions    []*IssueAlertConfigurationEntry `json:"actions"`
+	Conditions []*IssueAlertConfigurationEntry `json:"conditions"`
+	Filters    []*IssueAlertConfigurationEntry `json:"filters"`
+}
+
+// IssueAlertConfigurationEntry represents an available condition, filter or
+// action.
+type IssueAlertConfigurationEntry struct {
+	ID         string                          `json:"id"`
+	Label      string                          `json:"label"`
+	Prompt     string                          `json:"prompt"`
+	Enabled    bool                            `json:"enabled"`
+	FormFields map[string]*IssueAlertFormField `json:"-"`
+}
+
+// IssueAlertFormField represents a form field of a condition, filter or
+// action.
+type IssueAlertFormField struct {
+	Type        string          `json:"type"`
+	Placeholder interface{}     `json:"placeholder"`
+	Choices     [][]interface{} `json:"choices"`
+}
+
+// UnmarshalJSON skips form fields which are not objects, such as the settings
+// of Sentry apps.
+func (e *IssueAlertConfigurationEntry) UnmarshalJSON(data []byte) error {
+	type entry IssueAlertConfigurationEntry
+	var raw struct {
+		*entry
+		FormFields map[string]json.RawMessage `json:"formFields"`
+	}
+	raw.entry = (*entry)(e)
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	e.FormFields = make(map[string]*IssueAlertFormField, len(raw.FormFields))
+	for name, v := range raw.FormFields {
+		field := new(IssueAlertFormField)
+		dec := json.NewDecoder(bytes.NewReader(v))
+		dec.UseNumber()
+		if err := dec.Decode(field); err != nil {
+			continue
+		}
+		e.FormFields[name] = field
+	}
+	return nil
+}
+
+// GetConfiguration lists the conditions, filters and actions available to
+// the issue alerts of a project.
+func (s *IssueAlertsService) GetConfiguration(ctx context.Context, organizationSlug string, projectSlug string) (*IssueAlertConfiguration, *Response, error) {
+	u := fmt.Sprintf("0/projects/%v/%v/rules/configuration/", organizationSlug, projectSlug)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	configuration := new(IssueAlertConfiguration)
+	resp, err := s.client.Do(ctx, req, configuration)
+	if err != nil {
+		return nil, resp, err
+	}
+	return configuration, resp, nil
+}
+
 // Delete an issue alert.
 func (s *IssueAlertsService) Delete(ctx context.Context, organizationSlug string, projectSlug string, id string) (*Response, error) {
 	u := fmt.Sprintf("0/projects/%v/%v/rules/%v/", organizationSlug, projectSlug, id)
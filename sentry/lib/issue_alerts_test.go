@@ -745,3 +745,139 @@ func TestIssueAlertsService_Delete(t *testing.T) {
 	_, err := client.IssueAlerts.Delete(ctx, "the-interstellar-jurisdiction", "pump-station", "12345")
 	require.NoError(t, err)
 }
+
+func TestIssueAlertsService_GetConfiguration(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/projects/the-interstellar-jurisdiction/pump-station/rules/configuration/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{
+			"actions": [
+				{
+					"id": "sentry.rules.actions.notify_event_service.NotifyEventServiceAction",
+					"label": "Send a notification via {service}",
+					"prompt": "Send a notification via an integration",
+					"enabled": true,
+					"formFields": {
+						"service": {
+							"type": "choice",
+							"choices": [["mail", "Mail"]]
+						}
+					}
+				},
+				{
+					"id": "sentry.rules.actions.notify_event_sentry_app.NotifyEventSentryAppAction",
+					"label": "Send a notification via Webhook App",
+					"enabled": true,
+					"formFields": {
+						"type": "alert-rule-settings",
+						"uri": "/alert-rule-action"
+					}
+				}
+			],
+			"conditions": [
+				{
+					"id": "sentry.rules.conditions.first_seen_event.FirstSeenEventCondition",
+					"label": "A new issue is created",
+					"enabled": true
+				}
+			],
+			"filters": [
+				{
+					"id": "sentry.rules.filters.level.LevelFilter",
+					"label": "The event's level is {match} {level}",
+					"enabled": true,
+					"formFields": {
+						"level": {
+							"type": "choice",
+							"choices": [[50, "fatal"], [40, "error"]]
+						}
+					}
+				}
+			]
+		}`)
+	})
+
+	ctx := context.Background()
+	configuration, _, err := client.IssueAlerts.GetConfiguration(ctx, "the-interstellar-jurisdiction", "pump-station")
+	require.NoError(t, err)
+
+	expected := &IssueAlertConfiguration{
+		Actions: []*IssueAlertConfigurationEntry{
+			{
+				ID:      "sentry.rules.actions.notify_event_service.NotifyEventServiceAction",
+				Label:   "Send a notification via {service}",
+				Prompt:  "Send a notification via an integration",
+				Enabled: true,
+				FormFields: map[string]*IssueAlertFormField{
+					"service": {
+						Type:    "choice",
+						Choices: [][]interface{}{{"mail", "Mail"}},
+					},
+				},
+			},
+			{
+				ID:         "sentry.rules.actions.notify_event_sentry_app.NotifyEventSentryAppAction",
+				Label:      "Send a notification via Webhook App",
+				Enabled:    true,
+				FormFields: map[string]*IssueAlertFormField{},
+			},
+		},
+		Conditions: []*IssueAlertConfigurationEntry{
+			{
+				ID:         "sentry.rules.conditions.first_seen_event.FirstSeenEventCondition",
+				Label:      "A new issue is created",
+				Enabled:    true,
+				FormFields: map[string]*IssueAlertFormField{},
+			},
+		},
+		Filters: []*IssueAlertConfigurationEntry{
+			{
+				ID:      "sentry.rules.filters.level.LevelFilter",
+				Label:   "The event's level is {match} {level}",
+				Enabled: true,
+				FormFields: map[string]*IssueAlertFormField{
+					"level": {
+						Type:    "choice",
+						Choices: [][]interface{}{{json.Number("50"), "fatal"}, {json.Number("40"), "error"}},
+					},
+				},
+			},
+		},
+	}
+	assert.Equal(t, expected, configuration)
+}
+
+func TestIssueAlertsService_CreateWithFailedAsyncTask(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	taskRequests := 0
+	mux.HandleFunc("/api/0/projects/the-interstellar-jurisdiction/pump-station/rule-task/fakeuuid/", func(w http.ResponseWriter, r *http.Request) {
+		taskRequests++
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{
+			"status": "failed",
+			"error": "The slack resource \"#dummy-channel\" does not exist or has not been granted access in the Dummy Slack workspace.",
+			"rule": null
+		}`)
+	})
+	mux.HandleFunc("/api/0/projects/the-interstellar-jurisdiction/pump-station/rules/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "POST", r)
+		w.WriteHeader(http.StatusAccepted)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"uuid": "fakeuuid"}`)
+	})
+
+	params := &IssueAlert{
+		ActionMatch: String("all"),
+		Frequency:   Int(30),
+		Name:        String("Notify errors"),
+	}
+	ctx := context.Background()
+	_, _, err := client.IssueAlerts.Create(ctx, "the-interstellar-jurisdiction", "pump-station", params)
+	require.EqualError(t, err, `The slack resource "#dummy-channel" does not exist or has not been granted access in the Dummy Slack workspace.`)
+	require.Equal(t, 1, taskRequests)
+}
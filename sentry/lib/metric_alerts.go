@@ -158,32 +158,41 @@ func (s *MetricAlertsService) getMetricAlertFromMetricAlertTaskDetail(ctx contex
 		return nil, nil, err
 	}
 
+	var alert *MetricAlert
 	var resp *Response
-	for i := 0; i < 5; i++ {
-		time.Sleep(5 * time.Second)
-
+	err = s.client.pollTask(ctx, func(ctx context.Context) (bool, error) {
 		taskDetail := new(MetricAlertTaskDetail)
-		resp, err := s.client.Do(ctx, req, taskDetail)
+		resp, err = s.client.Do(ctx, req, taskDetail)
 		if err != nil {
-			return nil, resp, err
+			var notFoundErr *NotFoundError
+			if errors.As(err, &notFoundErr) {
+				return false, fmt.Errorf("cannot find metric alert creation task with UUID %v", taskUUID)
+			}
+			return false, err
 		}
-
-		if resp.StatusCode == 404 {
-			return nil, resp, fmt.Errorf("cannot find metric alert creation task with UUID %v", taskUUID)
+		if taskDetail.Status == nil {
+			return false, nil
 		}
-		if taskDetail.Status != nil && taskDetail.AlertRule != nil {
-			if *taskDetail.Status == "success" {
-				return taskDetail.AlertRule, resp, err
-			} else if *taskDetail.Status == "failed" {
-				if taskDetail != nil {
-					return taskDetail.AlertRule, resp, errors.New(*taskDetail.Error)
-				}
-
-				return taskDetail.AlertRule, resp, errors.New("error while running the metric alert creation task")
+		switch *taskDetail.Status {
+		case "success":
+			if taskDetail.AlertRule == nil {
+				return false, nil
+			}
+			alert = taskDetail.AlertRule
+			return true, nil
+		case "failed":
+			// Failed tasks have no rule, only an error.
+			if taskDetail.Error != nil {
+				return false, errors.New(*taskDetail.Error)
 			}
+			return false, errors.New("error while running the metric alert creation task")
 		}
+		return false, nil
+	})
+	if errors.Is(err, ErrTaskTimeout) || errors.Is(err, context.DeadlineExceeded) {
+		return nil, resp, fmt.Errorf("getting the status of the metric alert creation from Sentry took too long: %w", err)
 	}
-	return nil, resp, errors.New("getting the status of the metric alert creation from Sentry took too long")
+	return alert, resp, err
 }
 
 // Delete an Alert Rule.
@@ -697,3 +697,33 @@ func TestMetricAlertService_Delete(t *testing.T) {
 	_, err := client.MetricAlerts.Delete(ctx, "the-interstellar-jurisdiction", "pump-station", "12345")
 	require.NoError(t, err)
 }
+
+func TestMetricAlertsService_CreateWithFailedAsyncTask(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	taskRequests := 0
+	mux.HandleFunc("/api/0/projects/the-interstellar-jurisdiction/pump-station/alert-rule-task/fakeuuid/", func(w http.ResponseWriter, r *http.Request) {
+		taskRequests++
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{
+			"status": "failed",
+			"error": "Could not find channel #dummy-channel.",
+			"alertRule": null
+		}`)
+	})
+	mux.HandleFunc("/api/0/projects/the-interstellar-jurisdiction/pump-station/alert-rules/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "POST", r)
+		w.WriteHeader(http.StatusAccepted)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"uuid": "fakeuuid"}`)
+	})
+
+	params := &MetricAlert{
+		Name: String("pump-station-alert"),
+	}
+	ctx := context.Background()
+	_, _, err := client.MetricAlerts.Create(ctx, "the-interstellar-jurisdiction", "pump-station", params)
+	assert.EqualError(t, err, "Could not find channel #dummy-channel.")
+	assert.Equal(t, 1, taskRequests)
+}
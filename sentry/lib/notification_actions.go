@@ -0,0 +1,111 @@
+package sentry
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// NotificationAction represents an organization notification action, e.g.
+// who is notified when spike protection is activated.
+// https://github.com/getsentry/sentry/blob/23.6.0/src/sentry/api/serializers/models/notification_action.py
+type NotificationAction struct {
+	ID               *json.Number  `json:"id"`
+	OrganizationID   *json.Number  `json:"organizationId"`
+	IntegrationID    *json.Number  `json:"integrationId"`
+	SentryAppID      *json.Number  `json:"sentryAppId"`
+	Projects         []json.Number `json:"projects"`
+	TriggerType      *string       `json:"triggerType"`
+	ServiceType      *string       `json:"serviceType"`
+	TargetType       *string       `json:"targetType"`
+	TargetIdentifier interface{}   `json:"targetIdentifier"`
+	TargetDisplay    *string       `json:"targetDisplay"`
+}
+
+// NotificationActionsService provides methods for accessing Sentry notification action API endpoints.
+// https://docs.sentry.io/api/alerts/
+type NotificationActionsService service
+
+// Get details on a notification action.
+// https://docs.sentry.io/api/alerts/retrieve-a-spike-protection-notification-action/
+func (s *NotificationActionsService) Get(ctx context.Context, organizationSlug string, actionID string) (*NotificationAction, *Response, error) {
+	u := fmt.Sprintf("0/organizations/%v/notifications/actions/%v/", organizationSlug, actionID)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	action := new(NotificationAction)
+	resp, err := s.client.Do(ctx, req, action)
+	if err != nil {
+		return nil, resp, err
+	}
+	return action, resp, nil
+}
+
+// CreateNotificationActionParams are the parameters for NotificationActionsService.Create.
+type CreateNotificationActionParams struct {
+	TriggerType      *string      `json:"triggerType"`
+	ServiceType      *string      `json:"serviceType"`
+	IntegrationID    *json.Number `json:"integrationId,omitempty"`
+	TargetIdentifier interface{}  `json:"targetIdentifier,omitempty"`
+	TargetDisplay    *string      `json:"targetDisplay,omitempty"`
+	Projects         []string     `json:"projects"`
+}
+
+// Create a new notification action for an organization.
+// https://docs.sentry.io/api/alerts/create-a-spike-protection-notification-action/
+func (s *NotificationActionsService) Create(ctx context.Context, organizationSlug string, params *CreateNotificationActionParams) (*NotificationAction, *Response, error) {
+	u := fmt.Sprintf("0/organizations/%v/notifications/actions/", organizationSlug)
+	req, err := s.client.NewRequest("POST", u, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	action := new(NotificationAction)
+	resp, err := s.client.Do(ctx, req, action)
+	if err != nil {
+		return nil, resp, err
+	}
+	return action, resp, nil
+}
+
+// UpdateNotificationActionParams are the parameters for NotificationActionsService.Update.
+// The whole action is replaced, so all fields must be provided.
+type UpdateNotificationActionParams struct {
+	TriggerType      *string      `json:"triggerType"`
+	ServiceType      *string      `json:"serviceType"`
+	IntegrationID    *json.Number `json:"integrationId,omitempty"`
+	TargetIdentifier interface{}  `json:"targetIdentifier,omitempty"`
+	TargetDisplay    *string      `json:"targetDisplay,omitempty"`
+	Projects         []string     `json:"projects"`
+}
+
+// Update a notification action.
+// https://docs.sentry.io/api/alerts/update-a-spike-protection-notification-action/
+func (s *NotificationActionsService) Update(ctx context.Context, organizationSlug string, actionID string, params *UpdateNotificationActionParams) (*NotificationAction, *Response, error) {
+	u := fmt.Sprintf("0/organizations/%v/notifications/actions/%v/", organizationSlug, actionID)
+	req, err := s.client.NewRequest("PUT", u, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	action := new(NotificationAction)
+	resp, err := s.client.Do(ctx, req, action)
+	if err != nil {
+		return nil, resp, err
+	}
+	return action, resp, nil
+}
+
+// Delete a notification action.
+// https://docs.sentry.io/api/alerts/delete-a-spike-protection-notification-action/
+func (s *NotificationActionsService) Delete(ctx context.Context, organizationSlug string, actionID string) (*Response, error) {
+	u := fmt.Sprintf("0/organizations/%v/notifications/actions/%v/", organizationSlug, actionID)
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
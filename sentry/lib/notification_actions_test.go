@@ -0,0 +1,161 @@
+package sentry
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotificationActionsService_Get(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/notifications/actions/836501735/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{
+			"id": 836501735,
+			"organizationId": 62848264,
+			"serviceType": "slack",
+			"targetDisplay": "#alerts",
+			"targetIdentifier": "C1234567890",
+			"targetType": "specific",
+			"triggerType": "spike-protection",
+			"integrationId": 5,
+			"sentryAppId": null,
+			"projects": [4505321021243392]
+		}`)
+	})
+
+	ctx := context.Background()
+	action, _, err := client.NotificationActions.Get(ctx, "the-interstellar-jurisdiction", "836501735")
+	assert.NoError(t, err)
+
+	expected := &NotificationAction{
+		ID:               JSONNumber(json.Number("836501735")),
+		OrganizationID:   JSONNumber(json.Number("62848264")),
+		IntegrationID:    JSONNumber(json.Number("5")),
+		Projects:         []json.Number{"4505321021243392"},
+		TriggerType:      String("spike-protection"),
+		ServiceType:      String("slack"),
+		TargetType:       String("specific"),
+		TargetIdentifier: "C1234567890",
+		TargetDisplay:    String("#alerts"),
+	}
+	assert.Equal(t, expected, action)
+}
+
+func TestNotificationActionsService_Create(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/notifications/actions/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "POST", r)
+		assertPostJSON(t, map[string]interface{}{
+			"triggerType":      "spike-protection",
+			"serviceType":      "sentry_notification",
+			"targetIdentifier": "default",
+			"targetDisplay":    "default",
+			"projects":         []interface{}{"pump-station"},
+		}, r)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{
+			"id": 836501735,
+			"organizationId": 62848264,
+			"serviceType": "sentry_notification",
+			"targetDisplay": "default",
+			"targetIdentifier": "default",
+			"targetType": "specific",
+			"triggerType": "spike-protection",
+			"integrationId": null,
+			"sentryAppId": null,
+			"projects": [4505321021243392]
+		}`)
+	})
+
+	params := &CreateNotificationActionParams{
+		TriggerType:      String("spike-protection"),
+		ServiceType:      String("sentry_notification"),
+		TargetIdentifier: "default",
+		TargetDisplay:    String("default"),
+		Projects:         []string{"pump-station"},
+	}
+	ctx := context.Background()
+	action, _, err := client.NotificationActions.Create(ctx, "the-interstellar-jurisdiction", params)
+	assert.NoError(t, err)
+
+	expected := &NotificationAction{
+		ID:               JSONNumber(json.Number("836501735")),
+		OrganizationID:   JSONNumber(json.Number("62848264")),
+		Projects:         []json.Number{"4505321021243392"},
+		TriggerType:      String("spike-protection"),
+		ServiceType:      String("sentry_notification"),
+		TargetType:       String("specific"),
+		TargetIdentifier: "default",
+		TargetDisplay:    String("default"),
+	}
+	assert.Equal(t, expected, action)
+}
+
+func TestNotificationActionsService_Update(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/notifications/actions/836501735/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "PUT", r)
+		assertPostJSON(t, map[string]interface{}{
+			"triggerType":      "spike-protection",
+			"serviceType":      "pagerduty",
+			"integrationId":    json.Number("5"),
+			"targetIdentifier": "123",
+			"targetDisplay":    "On-call",
+			"projects":         []interface{}{"pump-station"},
+		}, r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{
+			"id": 836501735,
+			"organizationId": 62848264,
+			"serviceType": "pagerduty",
+			"targetDisplay": "On-call",
+			"targetIdentifier": "123",
+			"targetType": "specific",
+			"triggerType": "spike-protection",
+			"integrationId": 5,
+			"sentryAppId": null,
+			"projects": [4505321021243392]
+		}`)
+	})
+
+	params := &UpdateNotificationActionParams{
+		TriggerType:      String("spike-protection"),
+		ServiceType:      String("pagerduty"),
+		IntegrationID:    JSONNumber(json.Number("5")),
+		TargetIdentifier: "123",
+		TargetDisplay:    String("On-call"),
+		Projects:         []string{"pump-station"},
+	}
+	ctx := context.Background()
+	action, _, err := client.NotificationActions.Update(ctx, "the-interstellar-jurisdiction", "836501735", params)
+	assert.NoError(t, err)
+	assert.Equal(t, String("pagerduty"), action.ServiceType)
+	assert.Equal(t, JSONNumber(json.Number("5")), action.IntegrationID)
+}
+
+func TestNotificationActionsService_Delete(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/notifications/actions/836501735/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "DELETE", r)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	_, err := client.NotificationActions.Delete(ctx, "the-interstellar-jurisdiction", "836501735")
+	assert.NoError(t, err)
+}
@@ -22,6 +22,13 @@ type OrganizationMember struct {
 	InviteStatus string          `json:"inviteStatus"`
 	InviterName  *string         `json:"inviterName"`
 	Teams        []string        `json:"teams"`
+	TeamRoles    []TeamRole      `json:"teamRoles"`
+}
+
+// TeamRole represents the role of an organization member in one of their teams.
+type TeamRole struct {
+	TeamSlug string  `json:"teamSlug"`
+	Role     *string `json:"role"`
 }
 
 const (
@@ -35,8 +42,16 @@ const (
 // OrganizationMembersService provides methods for accessing Sentry membership API endpoints.
 type OrganizationMembersService service
 
+// ListOrganizationMembersParams are the parameters for OrganizationMembersService.List.
+type ListOrganizationMembersParams struct {
+	ListCursorParams
+
+	// Query filters members, e.g. with tokens such as "email:jane@example.com".
+	Query string `url:"query,omitempty"`
+}
+
 // List organization members.
-func (s *OrganizationMembersService) List(ctx context.Context, organizationSlug string, params *ListCursorParams) ([]*OrganizationMember, *Response, error) {
+func (s *OrganizationMembersService) List(ctx context.Context, organizationSlug string, params *ListOrganizationMembersParams) ([]*OrganizationMember, *Response, error) {
 	u := fmt.Sprintf("0/organizations/%v/members/", organizationSlug)
 	u, err := addQuery(u, params)
 	if err != nil {
@@ -71,10 +86,13 @@ func (s *OrganizationMembersService) Get(ctx context.Context, organizationSlug s
 	return member, resp, nil
 }
 
+// CreateOrganizationMemberParams are the parameters for
+// OrganizationMembersService.Create. Teams is only sent when set, and may
+// point to an empty list.
 type CreateOrganizationMemberParams struct {
-	Email string   `json:"email"`
-	Role  string   `json:"role"`
-	Teams []string `json:"teams,omitempty"`
+	Email string    `json:"email"`
+	Role  string    `json:"role"`
+	Teams *[]string `json:"teams,omitempty"`
 }
 
 func (s *OrganizationMembersService) Create(ctx context.Context, organizationSlug string, params *CreateOrganizationMemberParams) (*OrganizationMember, *Response, error) {
@@ -92,9 +110,12 @@ func (s *OrganizationMembersService) Create(ctx context.Context, organizationSlu
 	return member, resp, nil
 }
 
+// UpdateOrganizationMemberParams are the parameters for
+// OrganizationMembersService.Update. Teams is only sent when set, and may
+// point to an empty list to remove the member from all teams.
 type UpdateOrganizationMemberParams struct {
-	Role  string   `json:"role"`
-	Teams []string `json:"teams,omitempty"`
+	Role  string    `json:"role"`
+	Teams *[]string `json:"teams,omitempty"`
 }
 
 func (s *OrganizationMembersService) Update(ctx context.Context, organizationSlug string, memberID string, params *UpdateOrganizationMemberParams) (*OrganizationMember, *Response, error) {
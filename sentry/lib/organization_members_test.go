@@ -2,6 +2,7 @@ package sentry
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"testing"
@@ -15,7 +16,7 @@ func TestOrganizationMembersService_List(t *testing.T) {
 
 	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/members/", func(w http.ResponseWriter, r *http.Request) {
 		assertMethod(t, "GET", r)
-		assertQuery(t, map[string]string{"cursor": "100:-1:1"}, r)
+		assertQuery(t, map[string]string{"cursor": "100:-1:1", "query": "email:test@example.com"}, r)
 		w.Header().Set("Content-Type", "application/json")
 		fmt.Fprint(w, `[
 			{
@@ -76,8 +77,11 @@ func TestOrganizationMembersService_List(t *testing.T) {
 	})
 
 	ctx := context.Background()
-	members, _, err := client.OrganizationMembers.List(ctx, "the-interstellar-jurisdiction", &ListCursorParams{
-		Cursor: "100:-1:1",
+	members, _, err := client.OrganizationMembers.List(ctx, "the-interstellar-jurisdiction", &ListOrganizationMembersParams{
+		ListCursorParams: ListCursorParams{
+			Cursor: "100:-1:1",
+		},
+		Query: "email:test@example.com",
 	})
 	assert.NoError(t, err)
 	expected := []*OrganizationMember{
@@ -376,3 +380,62 @@ func TestOrganizationMembersService_Delete(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, int64(0), resp.ContentLength)
 }
+
+func TestOrganizationMembersService_Get_teamRoles(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/members/1/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{
+			"id": "1",
+			"email": "test@example.com",
+			"role": "member",
+			"teams": ["ancient-gabelers"],
+			"teamRoles": [
+				{
+					"teamSlug": "ancient-gabelers",
+					"role": "admin"
+				}
+			]
+		}`)
+	})
+
+	ctx := context.Background()
+	member, _, err := client.OrganizationMembers.Get(ctx, "the-interstellar-jurisdiction", "1")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"ancient-gabelers"}, member.Teams)
+	assert.Equal(t, []TeamRole{
+		{
+			TeamSlug: "ancient-gabelers",
+			Role:     String("admin"),
+		},
+	}, member.TeamRoles)
+}
+
+func TestOrganizationMembersService_Update_teams(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var body map[string]interface{}
+	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/members/1/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "PUT", r)
+		body = nil
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"id": "1", "role": "member", "teams": []}`)
+	})
+
+	ctx := context.Background()
+
+	// Teams which are not set are left alone.
+	_, _, err := client.OrganizationMembers.Update(ctx, "the-interstellar-jurisdiction", "1", &UpdateOrganizationMemberParams{Role: "member"})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"role": "member"}, body)
+
+	// An empty list removes the member from all teams.
+	_, _, err = client.OrganizationMembers.Update(ctx, "the-interstellar-jurisdiction", "1", &UpdateOrganizationMemberParams{Role: "member", Teams: &[]string{}})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"role": "member", "teams": []interface{}{}}, body)
+}
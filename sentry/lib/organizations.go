@@ -26,6 +26,12 @@ type OrganizationAvailableRole struct {
 	Name *string `json:"name"`
 }
 
+// OrganizationLinks represents the URLs of a Sentry organization.
+type OrganizationLinks struct {
+	OrganizationURL *string `json:"organizationUrl"`
+	RegionURL       *string `json:"regionUrl"`
+}
+
 // Organization represents detailed information about a Sentry organization.
 // Based on https://github.com/getsentry/sentry/blob/22.5.0/src/sentry/api/serializers/models/organization.py#L263-L288
 type Organization struct {
@@ -40,6 +46,7 @@ type Organization struct {
 	RequireEmailVerification *bool               `json:"requireEmailVerification,omitempty"`
 	Avatar                   *Avatar             `json:"avatar,omitempty"`
 	Features                 []string            `json:"features,omitempty"`
+	Links                    *OrganizationLinks  `json:"links,omitempty"`
 
 	// Detailed
 	// TODO: experiments
@@ -139,6 +146,28 @@ func (s *OrganizationsService) Create(ctx context.Context, params *CreateOrganiz
 type UpdateOrganizationParams struct {
 	Name *string `json:"name,omitempty"`
 	Slug *string `json:"slug,omitempty"`
+
+	// Settings
+	IsEarlyAdopter           *bool     `json:"isEarlyAdopter,omitempty"`
+	Require2FA               *bool     `json:"require2FA,omitempty"`
+	RequireEmailVerification *bool     `json:"requireEmailVerification,omitempty"`
+	DefaultRole              *string   `json:"defaultRole,omitempty"`
+	OpenMembership           *bool     `json:"openMembership,omitempty"`
+	AllowSharedIssues        *bool     `json:"allowSharedIssues,omitempty"`
+	EnhancedPrivacy          *bool     `json:"enhancedPrivacy,omitempty"`
+	DataScrubber             *bool     `json:"dataScrubber,omitempty"`
+	DataScrubberDefaults     *bool     `json:"dataScrubberDefaults,omitempty"`
+	SensitiveFields          *[]string `json:"sensitiveFields,omitempty"`
+	SafeFields               *[]string `json:"safeFields,omitempty"`
+	StoreCrashReports        *int      `json:"storeCrashReports,omitempty"`
+	AttachmentsRole          *string   `json:"attachmentsRole,omitempty"`
+	DebugFilesRole           *string   `json:"debugFilesRole,omitempty"`
+	EventsMemberAdmin        *bool     `json:"eventsMemberAdmin,omitempty"`
+	AlertsMemberWrite        *bool     `json:"alertsMemberWrite,omitempty"`
+	ScrubIPAddresses         *bool     `json:"scrubIPAddresses,omitempty"`
+	ScrapeJavaScript         *bool     `json:"scrapeJavaScript,omitempty"`
+	AllowJoinRequests        *bool     `json:"allowJoinRequests,omitempty"`
+	RelayPiiConfig           *string   `json:"relayPiiConfig,omitempty"`
 }
 
 // Update a Sentry organization.
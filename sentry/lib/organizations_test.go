@@ -2,6 +2,7 @@ package sentry
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"testing"
@@ -441,3 +442,59 @@ func TestOrganizationsService_Delete(t *testing.T) {
 	_, err := client.Organizations.Delete(ctx, "the-interstellar-jurisdiction")
 	assert.NoError(t, err)
 }
+
+func TestOrganizationsService_UpdateSettings(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "PUT", r)
+		assertPostJSON(t, map[string]interface{}{
+			"require2FA":        true,
+			"scrubIPAddresses":  true,
+			"openMembership":    false,
+			"sensitiveFields":   []interface{}{},
+			"safeFields":        []interface{}{"email"},
+			"storeCrashReports": json.Number("-1"),
+			"attachmentsRole":   "admin",
+		}, r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{
+			"id": "2",
+			"slug": "the-interstellar-jurisdiction",
+			"require2FA": true,
+			"scrubIPAddresses": true,
+			"openMembership": false,
+			"sensitiveFields": [],
+			"safeFields": ["email"],
+			"storeCrashReports": -1,
+			"attachmentsRole": "admin"
+		}`)
+	})
+
+	params := &UpdateOrganizationParams{
+		Require2FA:        Bool(true),
+		ScrubIPAddresses:  Bool(true),
+		OpenMembership:    Bool(false),
+		SensitiveFields:   &[]string{},
+		SafeFields:        &[]string{"email"},
+		StoreCrashReports: Int(-1),
+		AttachmentsRole:   String("admin"),
+	}
+	ctx := context.Background()
+	organization, _, err := client.Organizations.Update(ctx, "the-interstellar-jurisdiction", params)
+	assert.NoError(t, err)
+
+	expected := &Organization{
+		ID:                String("2"),
+		Slug:              String("the-interstellar-jurisdiction"),
+		Require2FA:        Bool(true),
+		ScrubIPAddresses:  Bool(true),
+		OpenMembership:    Bool(false),
+		SensitiveFields:   []string{},
+		SafeFields:        []string{"email"},
+		StoreCrashReports: Int(-1),
+		AttachmentsRole:   String("admin"),
+	}
+	assert.Equal(t, expected, organization)
+}
@@ -0,0 +1,76 @@
+package sentry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// ErrStopPagination can be returned by the callback of ForEachPage to stop
+// requesting further pages without failing.
+var ErrStopPagination = errors.New("stop pagination")
+
+// ErrPageLimitExceeded is returned when more pages are available than allowed
+// by PaginationOptions.MaxPages.
+var ErrPageLimitExceeded = errors.New("page limit exceeded")
+
+// PaginationOptions controls how ForEachPage and ListAll walk the pages of a
+// cursor-paginated endpoint.
+type PaginationOptions struct {
+	// MaxPages caps the number of requested pages. Zero means no limit.
+	MaxPages int
+}
+
+// PageFunc requests a single page of results, starting at the given cursor.
+// An empty cursor requests the first page.
+type PageFunc[T any] func(ctx context.Context, cursor ListCursorParams) ([]T, *Response, error)
+
+// ForEachPage requests every page of a cursor-paginated endpoint and calls fn
+// with the results of each page, in order.
+//
+// It stops when the last page has been requested, when fn returns an error,
+// or when ctx is done. Returning ErrStopPagination from fn stops without an
+// error. If opts.MaxPages is reached while more pages are available,
+// ErrPageLimitExceeded is returned.
+func ForEachPage[T any](ctx context.Context, opts *PaginationOptions, list PageFunc[T], fn func(page []T) error) (*Response, error) {
+	var cursor ListCursorParams
+	for pages := 0; ; pages++ {
+		if opts != nil && opts.MaxPages > 0 && pages >= opts.MaxPages {
+			return nil, fmt.Errorf("%w: more than %d pages", ErrPageLimitExceeded, opts.MaxPages)
+		}
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		page, resp, err := list(ctx, cursor)
+		if err != nil {
+			return resp, err
+		}
+
+		if err := fn(page); err != nil {
+			if errors.Is(err, ErrStopPagination) {
+				return resp, nil
+			}
+			return resp, err
+		}
+
+		if resp == nil || resp.Cursor == "" {
+			return resp, nil
+		}
+		cursor.Cursor = resp.Cursor
+	}
+}
+
+// ListAll requests every page of a cursor-paginated endpoint and returns the
+// concatenated results. See ForEachPage.
+func ListAll[T any](ctx context.Context, opts *PaginationOptions, list PageFunc[T]) ([]T, error) {
+	var all []T
+	_, err := ForEachPage(ctx, opts, list, func(page []T) error {
+		all = append(all, page...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return all, nil
+}
@@ -0,0 +1,97 @@
+package sentry
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func setupPaginatedTeams(t *testing.T, mux *http.ServeMux, pages int) {
+	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/teams/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+
+		page := 0
+		if cursor := r.URL.Query().Get("cursor"); cursor != "" {
+			fmt.Sscanf(cursor, "100:%d:0", &page)
+		}
+
+		hasNext := page+1 < pages
+		w.Header().Set("Link", fmt.Sprintf(`<%[1]s?cursor=100:%[2]d:0>; rel="next"; results="%[3]t"; cursor="100:%[2]d:0"`, r.URL.Path, page+1, hasNext))
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `[{"id": "%[1]d", "slug": "team-%[1]d"}]`, page)
+	})
+}
+
+func listTeamsPage(client *Client) PageFunc[*Team] {
+	return func(ctx context.Context, cursor ListCursorParams) ([]*Team, *Response, error) {
+		return client.Teams.List(ctx, "the-interstellar-jurisdiction", &cursor)
+	}
+}
+
+func TestListAll(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+	setupPaginatedTeams(t, mux, 3)
+
+	ctx := context.Background()
+	teams, err := ListAll(ctx, nil, listTeamsPage(client))
+	assert.NoError(t, err)
+
+	slugs := make([]string, 0, len(teams))
+	for _, team := range teams {
+		slugs = append(slugs, *team.Slug)
+	}
+	assert.Equal(t, []string{"team-0", "team-1", "team-2"}, slugs)
+}
+
+func TestListAll_maxPages(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+	setupPaginatedTeams(t, mux, 3)
+
+	ctx := context.Background()
+	teams, err := ListAll(ctx, &PaginationOptions{MaxPages: 3}, listTeamsPage(client))
+	assert.NoError(t, err)
+	assert.Len(t, teams, 3)
+
+	_, err = ListAll(ctx, &PaginationOptions{MaxPages: 2}, listTeamsPage(client))
+	assert.ErrorIs(t, err, ErrPageLimitExceeded)
+}
+
+func TestListAll_canceledContext(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+	setupPaginatedTeams(t, mux, 3)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	requested := 0
+	_, err := ForEachPage(ctx, nil, listTeamsPage(client), func(page []*Team) error {
+		requested++
+		cancel()
+		return nil
+	})
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, 1, requested)
+}
+
+func TestForEachPage_stop(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+	setupPaginatedTeams(t, mux, 3)
+
+	ctx := context.Background()
+	requested := 0
+	resp, err := ForEachPage(ctx, nil, listTeamsPage(client), func(page []*Team) error {
+		requested++
+		if *page[0].Slug == "team-1" {
+			return ErrStopPagination
+		}
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, requested)
+	assert.Equal(t, "100:2:0", resp.Cursor)
+}
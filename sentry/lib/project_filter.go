@@ -1,6 +1,7 @@
 package sentry
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -9,11 +10,43 @@ import (
 
 // ProjectFilter represents inbounding filters applied to a project.
 type ProjectFilter struct {
-	ID     string          `json:"id"`
-	Active json.RawMessage `json:"active"`
+	ID          string              `json:"id"`
+	Active      ProjectFilterActive `json:"active"`
+	Name        string              `json:"name"`
+	Description string              `json:"description"`
 }
 
-// ProjectOwnershipService provides methods for accessing Sentry project
+// ProjectFilterActive represents the state of an inbound data filter.
+// Most filters are either on or off and are returned as a boolean, while
+// filters with subfilters (e.g. legacy-browsers) are returned as the list of
+// active subfilters.
+type ProjectFilterActive struct {
+	Enabled    bool
+	Subfilters []string
+}
+
+func (a *ProjectFilterActive) UnmarshalJSON(b []byte) error {
+	b = bytes.TrimSpace(b)
+	if len(b) > 0 && b[0] == '[' {
+		if err := json.Unmarshal(b, &a.Subfilters); err != nil {
+			return err
+		}
+		a.Enabled = len(a.Subfilters) > 0
+		return nil
+	}
+
+	a.Subfilters = nil
+	return json.Unmarshal(b, &a.Enabled)
+}
+
+func (a ProjectFilterActive) MarshalJSON() ([]byte, error) {
+	if a.Subfilters != nil {
+		return json.Marshal(a.Subfilters)
+	}
+	return json.Marshal(a.Enabled)
+}
+
+// ProjectFilterService provides methods for accessing Sentry project
 // filters API endpoints.
 type ProjectFilterService service
 
@@ -52,32 +85,34 @@ func (s *ProjectFilterService) GetFilterConfig(ctx context.Context, organization
 	for _, filter := range filters {
 		switch filter.ID {
 		case "browser-extensions":
-			if string(filter.Active) == "true" {
-				filterConfig.BrowserExtension = true
-			}
-
+			filterConfig.BrowserExtension = filter.Active.Enabled
 		case "legacy-browsers":
-			if string(filter.Active) != "false" {
-				err = json.Unmarshal(filter.Active, &filterConfig.LegacyBrowsers)
-				if err != nil {
-					return nil, resp, err
-				}
-			}
+			filterConfig.LegacyBrowsers = filter.Active.Subfilters
 		}
 	}
 
 	return &filterConfig, resp, err
 }
 
-// BrowserExtensionParams defines parameters for browser extension request
-type BrowserExtensionParams struct {
-	Active bool `json:"active"`
+// UpdateProjectFilterParams are the parameters for ProjectFilterService.Update.
+// Filters with subfilters are configured by setting Subfilters, which may be
+// empty to disable every subfilter. All other filters are toggled with Active.
+type UpdateProjectFilterParams struct {
+	Active     *bool
+	Subfilters []string
 }
 
-// UpdateBrowserExtensions updates configuration for browser extension filter
-func (s *ProjectFilterService) UpdateBrowserExtensions(ctx context.Context, organizationSlug string, projectSlug string, active bool) (*Response, error) {
-	url := fmt.Sprintf("0/projects/%v/%v/filters/browser-extensions/", organizationSlug, projectSlug)
-	params := BrowserExtensionParams{active}
+func (p UpdateProjectFilterParams) MarshalJSON() ([]byte, error) {
+	if p.Subfilters != nil {
+		return json.Marshal(map[string]interface{}{"subfilters": p.Subfilters})
+	}
+	return json.Marshal(map[string]interface{}{"active": BoolValue(p.Active)})
+}
+
+// Update the configuration of an inbound data filter.
+// https://docs.sentry.io/api/projects/update-an-inbound-data-filter/
+func (s *ProjectFilterService) Update(ctx context.Context, organizationSlug string, projectSlug string, filterID string, params *UpdateProjectFilterParams) (*Response, error) {
+	url := fmt.Sprintf("0/projects/%v/%v/filters/%v/", organizationSlug, projectSlug, filterID)
 	req, err := s.client.NewRequest(http.MethodPut, url, params)
 	if err != nil {
 		return nil, err
@@ -86,20 +121,17 @@ func (s *ProjectFilterService) UpdateBrowserExtensions(ctx context.Context, orga
 	return s.client.Do(ctx, req, nil)
 }
 
-// LegacyBrowserParams defines parameters for legacy browser request
-type LegacyBrowserParams struct {
-	Browsers []string `json:"subfilters"`
+// UpdateBrowserExtensions updates configuration for browser extension filter
+func (s *ProjectFilterService) UpdateBrowserExtensions(ctx context.Context, organizationSlug string, projectSlug string, active bool) (*Response, error) {
+	params := &UpdateProjectFilterParams{Active: Bool(active)}
+	return s.Update(ctx, organizationSlug, projectSlug, "browser-extensions", params)
 }
 
 // UpdateLegacyBrowser updates configuration for legacy browser filters
 func (s *ProjectFilterService) UpdateLegacyBrowser(ctx context.Context, organizationSlug string, projectSlug string, browsers []string) (*Response, error) {
-	url := fmt.Sprintf("0/projects/%v/%v/filters/legacy-browsers/", organizationSlug, projectSlug)
-	params := LegacyBrowserParams{browsers}
-
-	req, err := s.client.NewRequest(http.MethodPut, url, params)
-	if err != nil {
-		return nil, err
+	if browsers == nil {
+		browsers = []string{}
 	}
-
-	return s.client.Do(ctx, req, nil)
+	params := &UpdateProjectFilterParams{Subfilters: browsers}
+	return s.Update(ctx, organizationSlug, projectSlug, "legacy-browsers", params)
 }
@@ -53,6 +53,99 @@ func TestProjectFilterService_GetWithoutLegacyExtension(t *testing.T) {
 	assert.Equal(t, &expected, filterConfig)
 }
 
+func TestProjectFilterService_Get(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/projects/the-interstellar-jurisdiction/powerful-abolitionist/filters/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, getWithLegacyExtensionHeader)
+	})
+
+	ctx := context.Background()
+	filters, _, err := client.ProjectFilter.Get(ctx, "the-interstellar-jurisdiction", "powerful-abolitionist")
+	assert.NoError(t, err)
+
+	expected := []*ProjectFilter{
+		{
+			ID:          "browser-extensions",
+			Active:      ProjectFilterActive{Enabled: false},
+			Name:        "name_1",
+			Description: "description_1",
+		},
+		{
+			ID:          "localhost",
+			Active:      ProjectFilterActive{Enabled: false},
+			Name:        "name_2",
+			Description: "description_2",
+		},
+		{
+			ID:          "legacy-browsers",
+			Active:      ProjectFilterActive{Enabled: true, Subfilters: []string{"ie_pre_9"}},
+			Name:        "name_3",
+			Description: "description_3",
+		},
+		{
+			ID:          "web-crawlers",
+			Active:      ProjectFilterActive{Enabled: true},
+			Name:        "name_4",
+			Description: "description_4",
+		},
+	}
+	assert.Equal(t, expected, filters)
+}
+
+func TestProjectFilterService_Update(t *testing.T) {
+	testCases := []struct {
+		name     string
+		filterID string
+		params   *UpdateProjectFilterParams
+		body     string
+	}{
+		{
+			name:     "active",
+			filterID: "web-crawlers",
+			params:   &UpdateProjectFilterParams{Active: Bool(true)},
+			body:     `{"active":true}`,
+		},
+		{
+			name:     "inactive",
+			filterID: "filtered-transaction",
+			params:   &UpdateProjectFilterParams{},
+			body:     `{"active":false}`,
+		},
+		{
+			name:     "subfilters",
+			filterID: "legacy-browsers",
+			params:   &UpdateProjectFilterParams{Subfilters: []string{"ie_pre_9", "safari_pre_6"}},
+			body:     `{"subfilters":["ie_pre_9","safari_pre_6"]}`,
+		},
+		{
+			name:     "no subfilters",
+			filterID: "legacy-browsers",
+			params:   &UpdateProjectFilterParams{Subfilters: []string{}},
+			body:     `{"subfilters":[]}`,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			client, mux, _, teardown := setup()
+			defer teardown()
+
+			mux.HandleFunc("/api/0/projects/test_org/test_project/filters/"+tc.filterID+"/", func(w http.ResponseWriter, r *http.Request) {
+				assertMethod(t, "PUT", r)
+				assert.Equal(t, tc.body, readRequestBody(r))
+				w.WriteHeader(http.StatusNoContent)
+			})
+
+			ctx := context.Background()
+			_, err := client.ProjectFilter.Update(ctx, "test_org", "test_project", tc.filterID, tc.params)
+			assert.NoError(t, err)
+		})
+	}
+}
+
 func readRequestBody(r *http.Request) string {
 	b, err := ioutil.ReadAll(r.Body)
 	defer r.Body.Close()
@@ -37,12 +37,12 @@ func (s *ProjectOwnershipsService) Get(ctx context.Context, organizationSlug str
 	return owner, resp, nil
 }
 
-// CreateProjectParams are the parameters for ProjectOwnershipService.Update.
+// UpdateProjectOwnershipParams are the parameters for ProjectOwnershipService.Update.
 type UpdateProjectOwnershipParams struct {
-	Raw                string `json:"raw,omitempty"`
-	FallThrough        *bool  `json:"fallthrough,omitempty"`
-	AutoAssignment     *bool  `json:"autoAssignment,omitempty"`
-	CodeownersAutoSync *bool  `json:"codeownersAutoSync,omitempty"`
+	Raw                *string `json:"raw,omitempty"`
+	FallThrough        *bool   `json:"fallthrough,omitempty"`
+	AutoAssignment     *bool   `json:"autoAssignment,omitempty"`
+	CodeownersAutoSync *bool   `json:"codeownersAutoSync,omitempty"`
 }
 
 // Update a Project's Ownership configuration
@@ -66,7 +66,7 @@ func TestProjectOwnershipsService_Update(t *testing.T) {
 	})
 
 	params := &UpdateProjectOwnershipParams{
-		Raw: "# assign issues to the product team, no matter the area\nurl:https://example.com/areas/*/*/products/* #product-team",
+		Raw: String("# assign issues to the product team, no matter the area\nurl:https://example.com/areas/*/*/products/* #product-team"),
 	}
 	ctx := context.Background()
 	ownership, _, err := client.ProjectOwnerships.Update(ctx, "the-interstellar-jurisdiction", "the-obese-philosophers", params)
@@ -47,8 +47,13 @@ type ProjectPlugin struct {
 type ProjectPluginsService service
 
 // List plugins bound to a project.
-func (s *ProjectPluginsService) List(ctx context.Context, organizationSlug string, projectSlug string) ([]*ProjectPlugin, *Response, error) {
+func (s *ProjectPluginsService) List(ctx context.Context, organizationSlug string, projectSlug string, params *ListCursorParams) ([]*ProjectPlugin, *Response, error) {
 	u := fmt.Sprintf("0/projects/%v/%v/plugins/", organizationSlug, projectSlug)
+	u, err := addQuery(u, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -0,0 +1,182 @@
+package sentry
+
+import (
+	"context"
+	"fmt"
+)
+
+// ProjectSymbolSourceLayout represents the folder structure of a symbol source.
+type ProjectSymbolSourceLayout struct {
+	Type   *string `json:"type,omitempty"`
+	Casing *string `json:"casing,omitempty"`
+}
+
+// ProjectSymbolSourceFilters restricts which debug files are looked up in a symbol source.
+type ProjectSymbolSourceFilters struct {
+	FileTypes        []string `json:"filetypes,omitempty"`
+	PathPatterns     []string `json:"path_patterns,omitempty"`
+	RequiresChecksum *bool    `json:"requires_checksum,omitempty"`
+}
+
+// ProjectSymbolSource represents a custom symbol source of a project.
+// Secrets such as passwords and private keys are never returned by the API.
+// https://github.com/getsentry/sentry/blob/23.6.0/src/sentry/api/endpoints/project_symbol_sources.py
+type ProjectSymbolSource struct {
+	ID      *string                     `json:"id"`
+	Type    *string                     `json:"type"`
+	Name    *string                     `json:"name"`
+	Layout  *ProjectSymbolSourceLayout  `json:"layout"`
+	Filters *ProjectSymbolSourceFilters `json:"filters"`
+
+	// HTTP
+	URL      *string `json:"url"`
+	Username *string `json:"username"`
+
+	// GCS and S3
+	Bucket *string `json:"bucket"`
+	Prefix *string `json:"prefix"`
+
+	// GCS
+	ClientEmail *string `json:"client_email"`
+
+	// S3
+	Region    *string `json:"region"`
+	AccessKey *string `json:"access_key"`
+}
+
+// ProjectSymbolSourcesService provides methods for accessing Sentry project
+// symbol source API endpoints.
+// https://docs.sentry.io/api/projects/
+type ProjectSymbolSourcesService service
+
+type projectSymbolSourceQueryParams struct {
+	ID string `url:"id,omitempty"`
+}
+
+// List the custom symbol sources of a project.
+// https://docs.sentry.io/api/projects/retrieve-a-projects-symbol-sources/
+func (s *ProjectSymbolSourcesService) List(ctx context.Context, organizationSlug string, projectSlug string, params *ListCursorParams) ([]*ProjectSymbolSource, *Response, error) {
+	u := fmt.Sprintf("0/projects/%v/%v/symbol-sources/", organizationSlug, projectSlug)
+	u, err := addQuery(u, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sources := []*ProjectSymbolSource{}
+	resp, err := s.client.Do(ctx, req, &sources)
+	if err != nil {
+		return nil, resp, err
+	}
+	return sources, resp, nil
+}
+
+// Get a custom symbol source of a project.
+// https://docs.sentry.io/api/projects/retrieve-a-projects-symbol-sources/
+func (s *ProjectSymbolSourcesService) Get(ctx context.Context, organizationSlug string, projectSlug string, id string) (*ProjectSymbolSource, *Response, error) {
+	u := fmt.Sprintf("0/projects/%v/%v/symbol-sources/", organizationSlug, projectSlug)
+	u, err := addQuery(u, &projectSymbolSourceQueryParams{ID: id})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	source := new(ProjectSymbolSource)
+	resp, err := s.client.Do(ctx, req, source)
+	if err != nil {
+		return nil, resp, err
+	}
+	return source, resp, nil
+}
+
+// ProjectSymbolSourceParams are the parameters for ProjectSymbolSourcesService.Create
+// and ProjectSymbolSourcesService.Update.
+type ProjectSymbolSourceParams struct {
+	ID      *string                     `json:"id,omitempty"`
+	Type    *string                     `json:"type"`
+	Name    *string                     `json:"name"`
+	Layout  *ProjectSymbolSourceLayout  `json:"layout,omitempty"`
+	Filters *ProjectSymbolSourceFilters `json:"filters,omitempty"`
+
+	// HTTP
+	URL      *string `json:"url,omitempty"`
+	Username *string `json:"username,omitempty"`
+	Password *string `json:"password,omitempty"`
+
+	// GCS and S3
+	Bucket *string `json:"bucket,omitempty"`
+	Prefix *string `json:"prefix,omitempty"`
+
+	// GCS
+	ClientEmail *string `json:"client_email,omitempty"`
+	PrivateKey  *string `json:"private_key,omitempty"`
+
+	// S3
+	Region    *string `json:"region,omitempty"`
+	AccessKey *string `json:"access_key,omitempty"`
+	SecretKey *string `json:"secret_key,omitempty"`
+}
+
+// Create a custom symbol source for a project.
+// https://docs.sentry.io/api/projects/add-a-symbol-source-to-a-project/
+func (s *ProjectSymbolSourcesService) Create(ctx context.Context, organizationSlug string, projectSlug string, params *ProjectSymbolSourceParams) (*ProjectSymbolSource, *Response, error) {
+	u := fmt.Sprintf("0/projects/%v/%v/symbol-sources/", organizationSlug, projectSlug)
+	req, err := s.client.NewRequest("POST", u, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	source := new(ProjectSymbolSource)
+	resp, err := s.client.Do(ctx, req, source)
+	if err != nil {
+		return nil, resp, err
+	}
+	return source, resp, nil
+}
+
+// Update a custom symbol source of a project.
+// https://docs.sentry.io/api/projects/update-a-projects-symbol-source/
+func (s *ProjectSymbolSourcesService) Update(ctx context.Context, organizationSlug string, projectSlug string, id string, params *ProjectSymbolSourceParams) (*ProjectSymbolSource, *Response, error) {
+	u := fmt.Sprintf("0/projects/%v/%v/symbol-sources/", organizationSlug, projectSlug)
+	u, err := addQuery(u, &projectSymbolSourceQueryParams{ID: id})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("PUT", u, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	source := new(ProjectSymbolSource)
+	resp, err := s.client.Do(ctx, req, source)
+	if err != nil {
+		return nil, resp, err
+	}
+	return source, resp, nil
+}
+
+// Delete a custom symbol source of a project.
+// https://docs.sentry.io/api/projects/delete-a-symbol-source-from-a-project/
+func (s *ProjectSymbolSourcesService) Delete(ctx context.Context, organizationSlug string, projectSlug string, id string) (*Response, error) {
+	u := fmt.Sprintf("0/projects/%v/%v/symbol-sources/", organizationSlug, projectSlug)
+	u, err := addQuery(u, &projectSymbolSourceQueryParams{ID: id})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
@@ -0,0 +1,212 @@
+package sentry
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProjectSymbolSourcesService_List(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/projects/the-interstellar-jurisdiction/pump-station/symbol-sources/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[
+			{
+				"id": "27c29bd8-d7a6-4a5a-b8f6-5a4d7a2f4b81",
+				"type": "http",
+				"name": "SymbolServer",
+				"layout": {"type": "native", "casing": "default"},
+				"url": "https://example.com",
+				"username": "admin",
+				"password": {"hidden-secret": true}
+			}
+		]`)
+	})
+
+	ctx := context.Background()
+	sources, _, err := client.ProjectSymbolSources.List(ctx, "the-interstellar-jurisdiction", "pump-station", nil)
+	assert.NoError(t, err)
+
+	expected := []*ProjectSymbolSource{
+		{
+			ID:   String("27c29bd8-d7a6-4a5a-b8f6-5a4d7a2f4b81"),
+			Type: String("http"),
+			Name: String("SymbolServer"),
+			Layout: &ProjectSymbolSourceLayout{
+				Type:   String("native"),
+				Casing: String("default"),
+			},
+			URL:      String("https://example.com"),
+			Username: String("admin"),
+		},
+	}
+	assert.Equal(t, expected, sources)
+}
+
+func TestProjectSymbolSourcesService_Get(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/projects/the-interstellar-jurisdiction/pump-station/symbol-sources/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assertQuery(t, map[string]string{"id": "27c29bd8-d7a6-4a5a-b8f6-5a4d7a2f4b81"}, r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{
+			"id": "27c29bd8-d7a6-4a5a-b8f6-5a4d7a2f4b81",
+			"type": "s3",
+			"name": "Amazon S3",
+			"layout": {"type": "native", "casing": "lowercase"},
+			"filters": {"filetypes": ["pe", "pdb"], "path_patterns": ["*.dll"], "requires_checksum": true},
+			"bucket": "s3-bucket-name",
+			"prefix": "symbols/",
+			"region": "us-east-1",
+			"access_key": "access_key",
+			"secret_key": {"hidden-secret": true}
+		}`)
+	})
+
+	ctx := context.Background()
+	source, _, err := client.ProjectSymbolSources.Get(ctx, "the-interstellar-jurisdiction", "pump-station", "27c29bd8-d7a6-4a5a-b8f6-5a4d7a2f4b81")
+	assert.NoError(t, err)
+
+	expected := &ProjectSymbolSource{
+		ID:   String("27c29bd8-d7a6-4a5a-b8f6-5a4d7a2f4b81"),
+		Type: String("s3"),
+		Name: String("Amazon S3"),
+		Layout: &ProjectSymbolSourceLayout{
+			Type:   String("native"),
+			Casing: String("lowercase"),
+		},
+		Filters: &ProjectSymbolSourceFilters{
+			FileTypes:        []string{"pe", "pdb"},
+			PathPatterns:     []string{"*.dll"},
+			RequiresChecksum: Bool(true),
+		},
+		Bucket:    String("s3-bucket-name"),
+		Prefix:    String("symbols/"),
+		Region:    String("us-east-1"),
+		AccessKey: String("access_key"),
+	}
+	assert.Equal(t, expected, source)
+}
+
+func TestProjectSymbolSourcesService_Create(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/projects/the-interstellar-jurisdiction/pump-station/symbol-sources/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "POST", r)
+		assertPostJSON(t, map[string]interface{}{
+			"type": "gcs",
+			"name": "Google Cloud Storage",
+			"layout": map[string]interface{}{
+				"type":   "native",
+				"casing": "default",
+			},
+			"bucket":       "gcs-bucket-name",
+			"client_email": "user@project.iam.gserviceaccount.com",
+			"private_key":  "private_key",
+		}, r)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{
+			"id": "27c29bd8-d7a6-4a5a-b8f6-5a4d7a2f4b81",
+			"type": "gcs",
+			"name": "Google Cloud Storage",
+			"layout": {"type": "native", "casing": "default"},
+			"bucket": "gcs-bucket-name",
+			"client_email": "user@project.iam.gserviceaccount.com",
+			"private_key": {"hidden-secret": true}
+		}`)
+	})
+
+	params := &ProjectSymbolSourceParams{
+		Type: String("gcs"),
+		Name: String("Google Cloud Storage"),
+		Layout: &ProjectSymbolSourceLayout{
+			Type:   String("native"),
+			Casing: String("default"),
+		},
+		Bucket:      String("gcs-bucket-name"),
+		ClientEmail: String("user@project.iam.gserviceaccount.com"),
+		PrivateKey:  String("private_key"),
+	}
+	ctx := context.Background()
+	source, _, err := client.ProjectSymbolSources.Create(ctx, "the-interstellar-jurisdiction", "pump-station", params)
+	assert.NoError(t, err)
+
+	expected := &ProjectSymbolSource{
+		ID:   String("27c29bd8-d7a6-4a5a-b8f6-5a4d7a2f4b81"),
+		Type: String("gcs"),
+		Name: String("Google Cloud Storage"),
+		Layout: &ProjectSymbolSourceLayout{
+			Type:   String("native"),
+			Casing: String("default"),
+		},
+		Bucket:      String("gcs-bucket-name"),
+		ClientEmail: String("user@project.iam.gserviceaccount.com"),
+	}
+	assert.Equal(t, expected, source)
+}
+
+func TestProjectSymbolSourcesService_Update(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/projects/the-interstellar-jurisdiction/pump-station/symbol-sources/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "PUT", r)
+		assertQuery(t, map[string]string{"id": "27c29bd8-d7a6-4a5a-b8f6-5a4d7a2f4b81"}, r)
+		assertPostJSON(t, map[string]interface{}{
+			"id":   "27c29bd8-d7a6-4a5a-b8f6-5a4d7a2f4b81",
+			"type": "http",
+			"name": "SymbolServer",
+			"url":  "https://example.com/symbols",
+		}, r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{
+			"id": "27c29bd8-d7a6-4a5a-b8f6-5a4d7a2f4b81",
+			"type": "http",
+			"name": "SymbolServer",
+			"url": "https://example.com/symbols"
+		}`)
+	})
+
+	params := &ProjectSymbolSourceParams{
+		ID:   String("27c29bd8-d7a6-4a5a-b8f6-5a4d7a2f4b81"),
+		Type: String("http"),
+		Name: String("SymbolServer"),
+		URL:  String("https://example.com/symbols"),
+	}
+	ctx := context.Background()
+	source, _, err := client.ProjectSymbolSources.Update(ctx, "the-interstellar-jurisdiction", "pump-station", "27c29bd8-d7a6-4a5a-b8f6-5a4d7a2f4b81", params)
+	assert.NoError(t, err)
+
+	expected := &ProjectSymbolSource{
+		ID:   String("27c29bd8-d7a6-4a5a-b8f6-5a4d7a2f4b81"),
+		Type: String("http"),
+		Name: String("SymbolServer"),
+		URL:  String("https://example.com/symbols"),
+	}
+	assert.Equal(t, expected, source)
+}
+
+func TestProjectSymbolSourcesService_Delete(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/projects/the-interstellar-jurisdiction/pump-station/symbol-sources/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "DELETE", r)
+		assertQuery(t, map[string]string{"id": "27c29bd8-d7a6-4a5a-b8f6-5a4d7a2f4b81"}, r)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	_, err := client.ProjectSymbolSources.Delete(ctx, "the-interstellar-jurisdiction", "pump-station", "27c29bd8-d7a6-4a5a-b8f6-5a4d7a2f4b81")
+	assert.NoError(t, err)
+}
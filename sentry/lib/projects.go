@@ -92,10 +92,23 @@ type ProjectSummaryTeam struct {
 // https://docs.sentry.io/api/projects/
 type ProjectsService service
 
-// List projects available.
-// https://docs.sentry.io/api/projects/list-your-projects/
-func (s *ProjectsService) List(ctx context.Context) ([]*Project, *Response, error) {
-	u := "0/projects/"
+// ListProjectsParams are the parameters for ProjectsService.List.
+type ListProjectsParams struct {
+	ListCursorParams
+
+	// Query filters projects, e.g. by name or with tokens such as "team:backend".
+	Query string `url:"query,omitempty"`
+}
+
+// List an organization's projects.
+// https://docs.sentry.io/api/organizations/list-an-organizations-projects/
+func (s *ProjectsService) List(ctx context.Context, organizationSlug string, params *ListProjectsParams) ([]*Project, *Response, error) {
+	u := fmt.Sprintf("0/organizations/%v/projects/", organizationSlug)
+	u, err := addQuery(u, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -159,9 +172,20 @@ type UpdateProjectParams struct {
 	DigestsMaxDelay      *int                   `json:"digestsMaxDelay,omitempty"`
 	ResolveAge           *int                   `json:"resolveAge,omitempty"`
 	Options              map[string]interface{} `json:"options,omitempty"`
-	AllowedDomains       []string               `json:"allowedDomains,omitempty"`
-	FingerprintingRules  string                 `json:"fingerprintingRules,omitempty"`
-	GroupingEnhancements string                 `json:"groupingEnhancements,omitempty"`
+	SubjectPrefix        *string                `json:"subjectPrefix,omitempty"`
+	SubjectTemplate      *string                `json:"subjectTemplate,omitempty"`
+	AllowedDomains       *[]string              `json:"allowedDomains,omitempty"`
+	DataScrubber         *bool                  `json:"dataScrubber,omitempty"`
+	DataScrubberDefaults *bool                  `json:"dataScrubberDefaults,omitempty"`
+	SensitiveFields      *[]string              `json:"sensitiveFields,omitempty"`
+	SafeFields           *[]string              `json:"safeFields,omitempty"`
+	ScrubIPAddresses     *bool                  `json:"scrubIPAddresses,omitempty"`
+	ScrapeJavaScript     *bool                  `json:"scrapeJavaScript,omitempty"`
+	SecurityToken        *string                `json:"securityToken,omitempty"`
+	SecurityTokenHeader  *string                `json:"securityTokenHeader,omitempty"`
+	VerifySSL            *bool                  `json:"verifySSL,omitempty"`
+	FingerprintingRules  *string                `json:"fingerprintingRules,omitempty"`
+	GroupingEnhancements *string                `json:"groupingEnhancements,omitempty"`
 }
 
 // Update various attributes and configurable settings for a given project.
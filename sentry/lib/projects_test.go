@@ -14,8 +14,9 @@ func TestProjectsService_List(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
 
-	mux.HandleFunc("/api/0/projects/", func(w http.ResponseWriter, r *http.Request) {
+	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/projects/", func(w http.ResponseWriter, r *http.Request) {
 		assertMethod(t, "GET", r)
+		assertQuery(t, map[string]string{"cursor": "100:1:0", "query": "team:powerful-abolitionist"}, r)
 		w.Header().Set("Content-Type", "application/json")
 		fmt.Fprint(w, `[{
 				"avatar": {
@@ -142,7 +143,11 @@ func TestProjectsService_List(t *testing.T) {
 	})
 
 	ctx := context.Background()
-	projects, _, err := client.Projects.List(ctx)
+	params := &ListProjectsParams{
+		ListCursorParams: ListCursorParams{Cursor: "100:1:0"},
+		Query:            "team:powerful-abolitionist",
+	}
+	projects, _, err := client.Projects.List(ctx, "the-interstellar-jurisdiction", params)
 	assert.NoError(t, err)
 
 	expectedOrganization := Organization{
@@ -586,6 +591,55 @@ func TestProjectsService_Update(t *testing.T) {
 	assert.Equal(t, expected, project)
 }
 
+func TestProjectsService_UpdateSettings(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/projects/the-interstellar-jurisdiction/plane-proxy/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "PUT", r)
+		assertPostJSON(t, map[string]interface{}{
+			"subjectPrefix":       "[plane]",
+			"allowedDomains":      []interface{}{"example.com"},
+			"sensitiveFields":     []interface{}{},
+			"scrubIPAddresses":    true,
+			"verifySSL":           false,
+			"fingerprintingRules": "",
+		}, r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{
+			"id": "5",
+			"slug": "plane-proxy",
+			"subjectPrefix": "[plane]",
+			"allowedDomains": ["example.com"],
+			"sensitiveFields": [],
+			"scrubIPAddresses": true,
+			"verifySSL": false,
+			"fingerprintingRules": ""
+		}`)
+	})
+
+	params := &UpdateProjectParams{
+		SubjectPrefix:       String("[plane]"),
+		AllowedDomains:      &[]string{"example.com"},
+		SensitiveFields:     &[]string{},
+		ScrubIPAddresses:    Bool(true),
+		VerifySSL:           Bool(false),
+		FingerprintingRules: String(""),
+	}
+	ctx := context.Background()
+	project, _, err := client.Projects.Update(ctx, "the-interstellar-jurisdiction", "plane-proxy", params)
+	assert.NoError(t, err)
+	expected := &Project{
+		ID:               "5",
+		Slug:             "plane-proxy",
+		SubjectPrefix:    "[plane]",
+		AllowedDomains:   []string{"example.com"},
+		SensitiveFields:  []string{},
+		ScrubIPAddresses: true,
+	}
+	assert.Equal(t, expected, project)
+}
+
 func TestProjectsService_Delete(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
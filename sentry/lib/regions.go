@@ -0,0 +1,153 @@
+package sentry
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// Organization-scoped endpoints carry the organization slug as their first
+// path parameter.
+var organizationScopedPath = regexp.MustCompile(`^0/(?:organizations|projects|teams)/([^/]+)/`)
+
+// RegionBaseURL returns the base API URL of a sentry.io region, e.g. "us" or
+// "de".
+func RegionBaseURL(region string) string {
+	return fmt.Sprintf("https://%s.sentry.io/api/", region)
+}
+
+// regionBaseURL returns the base API URL of the region the organization is
+// hosted in. Results are cached per organization, and concurrent lookups of
+// the same organization share a single request.
+func (c *Client) regionBaseURL(ctx context.Context, organizationSlug string) (*url.URL, error) {
+	if u, ok := c.cachedRegionBaseURL(organizationSlug); ok {
+		return u, nil
+	}
+
+	// The lookup is shared with other callers, so it must not fail when the
+	// context of this caller is canceled.
+	ch := c.regionLookups.DoChan(organizationSlug, func() (interface{}, error) {
+		if u, ok := c.cachedRegionBaseURL(organizationSlug); ok {
+			return u, nil
+		}
+
+		lookupCtx, cancel := context.WithTimeout(detachedContext{ctx}, regionLookupTimeout)
+		defer cancel()
+		u, err := c.lookupRegionBaseURL(lookupCtx, organizationSlug)
+		if err != nil {
+			return nil, err
+		}
+
+		c.regionMu.Lock()
+		defer c.regionMu.Unlock()
+		if c.regionURLs == nil {
+			c.regionURLs = make(map[string]*url.URL)
+		}
+		c.regionURLs[organizationSlug] = u
+		return u, nil
+	})
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case res := <-ch:
+		if res.Err != nil {
+			return nil, res.Err
+		}
+		return res.Val.(*url.URL), nil
+	}
+}
+
+// regionLookupTimeout bounds the lookup of the region of an organization,
+// which does not end with the context of the request that started it.
+const regionLookupTimeout = time.Minute
+
+// detachedContext keeps the values of a context, but not its deadline and
+// cancellation.
+type detachedContext struct {
+	context.Context
+}
+
+func (detachedContext) Deadline() (time.Time, bool) { return time.Time{}, false }
+func (detachedContext) Done() <-chan struct{}       { return nil }
+func (detachedContext) Err() error                  { return nil }
+
+func (c *Client) cachedRegionBaseURL(organizationSlug string) (*url.URL, bool) {
+	c.regionMu.Lock()
+	defer c.regionMu.Unlock()
+
+	u, ok := c.regionURLs[organizationSlug]
+	return u, ok
+}
+
+// lookupRegionBaseURL requests the region of the organization. The configured
+// base URL is returned if the token may not read the organization or the
+// organization does not exist, so that the lookup isn't repeated for every
+// request.
+func (c *Client) lookupRegionBaseURL(ctx context.Context, organizationSlug string) (*url.URL, error) {
+	req, err := c.NewRequest("GET", fmt.Sprintf("0/organizations/%v/", organizationSlug), nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.bareDo(ctx, req)
+	if err != nil {
+		var permissionErr *PermissionError
+		var notFoundErr *NotFoundError
+		if errors.As(err, &permissionErr) || errors.As(err, &notFoundErr) {
+			return c.BaseURL, nil
+		}
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	org := new(Organization)
+	if err := json.NewDecoder(resp.Body).Decode(org); err != nil {
+		return nil, err
+	}
+
+	if org.Links != nil && org.Links.RegionURL != nil && *org.Links.RegionURL != "" {
+		return url.Parse(strings.TrimSuffix(*org.Links.RegionURL, "/") + "/api/")
+	}
+	return c.BaseURL, nil
+}
+
+// routeToRegion rewrites requests to organization-scoped endpoints to the
+// region the organization is hosted in. Other requests are left untouched.
+func (c *Client) routeToRegion(ctx context.Context, req *http.Request) (*http.Request, error) {
+	if req.URL.Host != c.BaseURL.Host || !strings.HasPrefix(req.URL.Path, c.BaseURL.Path) {
+		return req, nil
+	}
+
+	path := strings.TrimPrefix(req.URL.Path, c.BaseURL.Path)
+	m := organizationScopedPath.FindStringSubmatch(path)
+	if m == nil {
+		return req, nil
+	}
+
+	baseURL, err := c.regionBaseURL(ctx, m[1])
+	if err != nil {
+		// Fall back to the configured base URL. The request itself surfaces
+		// a more meaningful error, e.g. when the organization does not exist.
+		return req, nil
+	}
+	if baseURL.Host == c.BaseURL.Host && baseURL.Path == c.BaseURL.Path {
+		return req, nil
+	}
+
+	u, err := baseURL.Parse(path)
+	if err != nil {
+		return nil, err
+	}
+	u.RawQuery = req.URL.RawQuery
+
+	req = req.Clone(ctx)
+	req.URL = u
+	req.Host = u.Host
+	return req, nil
+}
@@ -0,0 +1,220 @@
+package sentry
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegionBaseURL(t *testing.T) {
+	assert.Equal(t, "https://de.sentry.io/api/", RegionBaseURL("de"))
+}
+
+func TestClient_RegionRouting(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+	client.RegionRouting = true
+
+	regionMux := http.NewServeMux()
+	regionServer := httptest.NewServer(regionMux)
+	defer regionServer.Close()
+
+	orgRequests := 0
+	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		orgRequests++
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{
+			"id": "2",
+			"slug": "the-interstellar-jurisdiction",
+			"links": {
+				"organizationUrl": "https://the-interstellar-jurisdiction.sentry.io",
+				"regionUrl": "%s"
+			}
+		}`, regionServer.URL)
+	})
+	mux.HandleFunc("/api/0/organizations/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[{"id": "2", "slug": "the-interstellar-jurisdiction"}]`)
+	})
+	regionMux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/teams/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assertQuery(t, map[string]string{"cursor": "100:1:0"}, r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[{"id": "3", "slug": "ancient-gabelers"}]`)
+	})
+	regionMux.HandleFunc("/api/0/projects/the-interstellar-jurisdiction/pump-station/keys/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[]`)
+	})
+
+	ctx := context.Background()
+	teams, _, err := client.Teams.List(ctx, "the-interstellar-jurisdiction", &ListCursorParams{Cursor: "100:1:0"})
+	assert.NoError(t, err)
+	assert.Len(t, teams, 1)
+
+	_, _, err = client.ProjectKeys.List(ctx, "the-interstellar-jurisdiction", "pump-station", nil)
+	assert.NoError(t, err)
+
+	// Requests which are not scoped to an organization stay on the base URL.
+	orgs, _, err := client.Organizations.List(ctx, nil)
+	assert.NoError(t, err)
+	assert.Len(t, orgs, 1)
+
+	assert.Equal(t, 1, orgRequests, "the region should be cached")
+}
+
+func TestClient_RegionRouting_unknownOrganization(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+	client.RegionRouting = true
+
+	mux.HandleFunc("/api/0/organizations/unknown/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"detail": "The requested resource does not exist"}`)
+	})
+
+	ctx := context.Background()
+	_, resp, err := client.Organizations.Get(ctx, "unknown")
+	assert.Error(t, err)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func TestClient_RegionRouting_concurrentLookups(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+	client.RegionRouting = true
+
+	var orgRequests int32
+	entered := make(chan struct{})
+	release := make(chan struct{})
+	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/", func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&orgRequests, 1) == 1 {
+			close(entered)
+		}
+		<-release
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"id": "2", "slug": "the-interstellar-jurisdiction"}`)
+	})
+	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/teams/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[]`)
+	})
+	mux.HandleFunc("/api/0/organizations/pump-station/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"id": "3", "slug": "pump-station"}`)
+	})
+	mux.HandleFunc("/api/0/organizations/pump-station/teams/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[]`)
+	})
+
+	ctx := context.Background()
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, _, err := client.Teams.List(ctx, "the-interstellar-jurisdiction", nil)
+			assert.NoError(t, err)
+		}()
+	}
+	<-entered
+
+	// A pending lookup does not block requests to other organizations.
+	otherCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	_, _, err := client.Teams.List(otherCtx, "pump-station", nil)
+	assert.NoError(t, err)
+
+	close(release)
+	wg.Wait()
+	assert.Equal(t, int32(1), atomic.LoadInt32(&orgRequests), "concurrent lookups should share a single request")
+}
+
+func TestClient_RegionRouting_forbiddenOrganization(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+	client.RegionRouting = true
+
+	orgRequests := 0
+	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/", func(w http.ResponseWriter, r *http.Request) {
+		orgRequests++
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `{"detail": "You do not have permission to perform this action."}`)
+	})
+	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/teams/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[]`)
+	})
+
+	ctx := context.Background()
+	for i := 0; i < 2; i++ {
+		_, _, err := client.Teams.List(ctx, "the-interstellar-jurisdiction", nil)
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, 1, orgRequests, "the fallback to the base URL should be cached")
+}
+
+func TestClient_RegionRouting_canceledLookup(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+	client.RegionRouting = true
+
+	regionMux := http.NewServeMux()
+	regionServer := httptest.NewServer(regionMux)
+	defer regionServer.Close()
+
+	var orgRequests int32
+	entered := make(chan struct{})
+	release := make(chan struct{})
+	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/", func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&orgRequests, 1) == 1 {
+			close(entered)
+		}
+		<-release
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"id": "2", "slug": "the-interstellar-jurisdiction", "links": {"regionUrl": "%s"}}`, regionServer.URL)
+	})
+	var regionRequests int32
+	regionMux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/teams/", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&regionRequests, 1)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[]`)
+	})
+
+	canceledCtx, cancel := context.WithCancel(context.Background())
+	canceled := make(chan error)
+	go func() {
+		_, _, err := client.Teams.List(canceledCtx, "the-interstellar-jurisdiction", nil)
+		canceled <- err
+	}()
+	<-entered
+	cancel()
+	assert.ErrorIs(t, <-canceled, context.Canceled)
+
+	// The lookup continues for the other callers of the organization.
+	done := make(chan error)
+	go func() {
+		_, _, err := client.Teams.List(context.Background(), "the-interstellar-jurisdiction", nil)
+		done <- err
+	}()
+	close(release)
+	assert.NoError(t, <-done)
+
+	_, _, err := client.Teams.List(context.Background(), "the-interstellar-jurisdiction", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&orgRequests))
+	assert.Equal(t, int32(2), atomic.LoadInt32(&regionRequests), "the region should be cached")
+}
@@ -3,13 +3,14 @@ package sentry
 import (
 	"context"
 	"fmt"
+	"net/url"
 	"time"
 )
 
 type ReleaseDeploymentsService service
 
 type ReleaseDeployment struct {
-	ID           string     `json:"id"`
+	ID           string     `json:"id,omitempty"`
 	Name         *string    `json:"name,omitempty"`
 	Environment  string     `json:"environment,omitempty"`
 	URL          *string    `json:"url,omitempty"`
@@ -18,53 +19,52 @@ type ReleaseDeployment struct {
 	DateFinished *time.Time `json:"dateFinished,omitempty"`
 }
 
-// Get a Release Deploy for a project.
-func (s *ReleaseDeploymentsService) Get(ctx context.Context, organizationSlug string, version string, deployID string) (*ReleaseDeployment, *Response, error) {
+// List the deploys of a release.
+func (s *ReleaseDeploymentsService) List(ctx context.Context, organizationSlug string, version string, params *ListCursorParams) ([]*ReleaseDeployment, *Response, error) {
+	u := fmt.Sprintf("0/organizations/%v/releases/%v/deploys/", organizationSlug, url.PathEscape(version))
+	u, err := addQuery(u, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
 
-	lastCursor := ""
+	deployments := []*ReleaseDeployment{}
+	resp, err := s.client.Do(ctx, req, &deployments)
+	if err != nil {
+		return nil, resp, err
+	}
+	return deployments, resp, nil
+}
 
+// Get a Release Deploy for a project.
+func (s *ReleaseDeploymentsService) Get(ctx context.Context, organizationSlug string, version string, deployID string) (*ReleaseDeployment, *Response, error) {
 	// Search for the deployment ID by using the list endpoint. When we have
 	// found the first match return immediately
-	for {
-		params := ListCursorParams{
-			Cursor: lastCursor,
-		}
-
-		u := fmt.Sprintf("0/organizations/%v/releases/%s/deploys/", organizationSlug, version)
-		u, err := addQuery(u, params)
-		if err != nil {
-			return nil, nil, err
-		}
-
-		req, err := s.client.NewRequest("GET", u, nil)
-		if err != nil {
-			return nil, nil, err
-		}
-
-		deployments := new([]ReleaseDeployment)
-		resp, err := s.client.Do(ctx, req, deployments)
-		if err != nil {
-			return nil, resp, err
-		}
-
-		for i := range *deployments {
-			d := (*deployments)[i]
+	var deployment *ReleaseDeployment
+	resp, err := ForEachPage(ctx, nil, func(ctx context.Context, cursor ListCursorParams) ([]*ReleaseDeployment, *Response, error) {
+		return s.List(ctx, organizationSlug, version, &cursor)
+	}, func(page []*ReleaseDeployment) error {
+		for _, d := range page {
 			if d.ID == deployID {
-				return &d, resp, nil
+				deployment = d
+				return ErrStopPagination
 			}
 		}
-
-		// No matches in the current page and no further pages to check
-		if resp.Cursor == "" {
-			return nil, resp, nil
-		}
-		lastCursor = resp.Cursor
+		return nil
+	})
+	if err != nil {
+		return nil, resp, err
 	}
+	return deployment, resp, nil
 }
 
 // Create a new Release Deploy to a project.
 func (s *ReleaseDeploymentsService) Create(ctx context.Context, organizationSlug string, version string, params *ReleaseDeployment) (*ReleaseDeployment, *Response, error) {
-	u := fmt.Sprintf("0/organizations/%v/releases/%s/deploys/", organizationSlug, version)
+	u := fmt.Sprintf("0/organizations/%v/releases/%v/deploys/", organizationSlug, url.PathEscape(version))
 	req, err := s.client.NewRequest("POST", u, params)
 	if err != nil {
 		return nil, nil, err
@@ -0,0 +1,128 @@
+package sentry
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReleaseDeploymentsService_Get(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/releases/1.0.0/deploys/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Query().Get("cursor") {
+		case "":
+			w.Header().Set("Link", `</api/0/organizations/the-interstellar-jurisdiction/releases/1.0.0/deploys/?&cursor=100:1:0>; rel="next"; results="true"; cursor="100:1:0"`)
+			fmt.Fprint(w, `[
+				{
+					"id": "1",
+					"environment": "staging",
+					"dateStarted": null,
+					"dateFinished": "2022-06-01T10:00:00Z",
+					"name": null,
+					"url": null
+				}
+			]`)
+		case "100:1:0":
+			w.Header().Set("Link", `</api/0/organizations/the-interstellar-jurisdiction/releases/1.0.0/deploys/?&cursor=100:2:0>; rel="next"; results="false"; cursor="100:2:0"`)
+			fmt.Fprint(w, `[
+				{
+					"id": "2",
+					"environment": "production",
+					"dateStarted": "2022-06-01T11:00:00Z",
+					"dateFinished": "2022-06-01T12:00:00Z",
+					"name": "Deploy 2",
+					"url": "https://example.com/deploys/2"
+				}
+			]`)
+		default:
+			t.Fatalf("unexpected cursor %q", r.URL.Query().Get("cursor"))
+		}
+	})
+
+	ctx := context.Background()
+	deploy, _, err := client.ReleaseDeployments.Get(ctx, "the-interstellar-jurisdiction", "1.0.0", "2")
+	assert.NoError(t, err)
+
+	expected := &ReleaseDeployment{
+		ID:           "2",
+		Environment:  "production",
+		Name:         String("Deploy 2"),
+		URL:          String("https://example.com/deploys/2"),
+		DateStarted:  Time(mustParseTime("2022-06-01T11:00:00Z")),
+		DateFinished: Time(mustParseTime("2022-06-01T12:00:00Z")),
+	}
+	assert.Equal(t, expected, deploy)
+
+	deploy, _, err = client.ReleaseDeployments.Get(ctx, "the-interstellar-jurisdiction", "1.0.0", "3")
+	assert.NoError(t, err)
+	assert.Nil(t, deploy)
+}
+
+func TestReleaseDeploymentsService_Create(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/releases/1.0.0/deploys/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "POST", r)
+		assertPostJSON(t, map[string]interface{}{
+			"environment": "production",
+			"name":        "Deploy 2",
+			"projects":    []interface{}{"pump-station"},
+		}, r)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{
+			"id": "2",
+			"environment": "production",
+			"dateStarted": null,
+			"dateFinished": "2022-06-01T12:00:00Z",
+			"name": "Deploy 2",
+			"url": null
+		}`)
+	})
+
+	params := &ReleaseDeployment{
+		Environment: "production",
+		Name:        String("Deploy 2"),
+		Projects:    []string{"pump-station"},
+	}
+	ctx := context.Background()
+	deploy, _, err := client.ReleaseDeployments.Create(ctx, "the-interstellar-jurisdiction", "1.0.0", params)
+	assert.NoError(t, err)
+
+	expected := &ReleaseDeployment{
+		ID:           "2",
+		Environment:  "production",
+		Name:         String("Deploy 2"),
+		DateFinished: Time(mustParseTime("2022-06-01T12:00:00Z")),
+	}
+	assert.Equal(t, expected, deploy)
+}
+
+func TestReleaseDeploymentsService_Create_escapesVersion(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/releases/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "POST", r)
+		assert.Equal(t, "/api/0/organizations/the-interstellar-jurisdiction/releases/pump-station%2F1.0.0/deploys/", r.URL.EscapedPath())
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id": "2", "environment": "production"}`)
+	})
+
+	params := &ReleaseDeployment{
+		Environment: "production",
+	}
+	ctx := context.Background()
+	deploy, _, err := client.ReleaseDeployments.Create(ctx, "the-interstellar-jurisdiction", "pump-station/1.0.0", params)
+	assert.NoError(t, err)
+	assert.Equal(t, "2", deploy.ID)
+}
@@ -0,0 +1,183 @@
+package sentry
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// Release represents a release of an organization.
+// https://github.com/getsentry/sentry/blob/22.5.0/src/sentry/api/serializers/models/release.py#L467-L494
+type Release struct {
+	ID           int              `json:"id"`
+	Version      string           `json:"version"`
+	ShortVersion string           `json:"shortVersion"`
+	Ref          *string          `json:"ref"`
+	URL          *string          `json:"url"`
+	DateCreated  time.Time        `json:"dateCreated"`
+	DateReleased *time.Time       `json:"dateReleased"`
+	FirstEvent   *time.Time       `json:"firstEvent"`
+	LastEvent    *time.Time       `json:"lastEvent"`
+	NewGroups    int              `json:"newGroups"`
+	CommitCount  int              `json:"commitCount"`
+	DeployCount  int              `json:"deployCount"`
+	Projects     []ReleaseProject `json:"projects"`
+}
+
+// ReleaseProject represents a project a release belongs to.
+type ReleaseProject struct {
+	ID        int      `json:"id"`
+	Slug      string   `json:"slug"`
+	Name      string   `json:"name"`
+	NewGroups int      `json:"newGroups"`
+	Platform  *string  `json:"platform"`
+	Platforms []string `json:"platforms"`
+}
+
+// ReleaseRef associates a release with a commit of a repository. Sentry
+// fetches the commits between PreviousCommit and Commit from the repository
+// integration.
+type ReleaseRef struct {
+	Repository     string  `json:"repository"`
+	Commit         string  `json:"commit"`
+	PreviousCommit *string `json:"previousCommit,omitempty"`
+}
+
+// ReleaseCommit represents a commit that is explicitly associated with a release.
+type ReleaseCommit struct {
+	ID          string     `json:"id"`
+	Repository  *string    `json:"repository,omitempty"`
+	Message     *string    `json:"message,omitempty"`
+	AuthorName  *string    `json:"author_name,omitempty"`
+	AuthorEmail *string    `json:"author_email,omitempty"`
+	Timestamp   *time.Time `json:"timestamp,omitempty"`
+}
+
+// ReleasesService provides methods for accessing Sentry release API endpoints.
+// https://docs.sentry.io/api/releases/
+type ReleasesService service
+
+// ListReleasesParams are the parameters for ReleasesService.List.
+type ListReleasesParams struct {
+	ListCursorParams
+
+	// Query filters releases by version.
+	Query string `url:"query,omitempty"`
+
+	// Project filters releases by the project ID.
+	Project string `url:"project,omitempty"`
+}
+
+// List an organization's releases.
+// https://docs.sentry.io/api/releases/list-an-organizations-releases/
+func (s *ReleasesService) List(ctx context.Context, organizationSlug string, params *ListReleasesParams) ([]*Release, *Response, error) {
+	u := fmt.Sprintf("0/organizations/%v/releases/", organizationSlug)
+	u, err := addQuery(u, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	releases := []*Release{}
+	resp, err := s.client.Do(ctx, req, &releases)
+	if err != nil {
+		return nil, resp, err
+	}
+	return releases, resp, nil
+}
+
+// Get details on a release.
+// https://docs.sentry.io/api/releases/retrieve-an-organizations-releases/
+func (s *ReleasesService) Get(ctx context.Context, organizationSlug string, version string) (*Release, *Response, error) {
+	u := fmt.Sprintf("0/organizations/%v/releases/%v/", organizationSlug, url.PathEscape(version))
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	release := new(Release)
+	resp, err := s.client.Do(ctx, req, release)
+	if err != nil {
+		return nil, resp, err
+	}
+	return release, resp, nil
+}
+
+// CreateReleaseParams are the parameters for ReleasesService.Create.
+type CreateReleaseParams struct {
+	Version      string          `json:"version"`
+	Projects     []string        `json:"projects"`
+	Ref          *string         `json:"ref,omitempty"`
+	URL          *string         `json:"url,omitempty"`
+	DateReleased *time.Time      `json:"dateReleased,omitempty"`
+	Refs         []ReleaseRef    `json:"refs,omitempty"`
+	Commits      []ReleaseCommit `json:"commits,omitempty"`
+}
+
+// Create a new release for an organization.
+// https://docs.sentry.io/api/releases/create-a-new-release-for-an-organization/
+func (s *ReleasesService) Create(ctx context.Context, organizationSlug string, params *CreateReleaseParams) (*Release, *Response, error) {
+	u := fmt.Sprintf("0/organizations/%v/releases/", organizationSlug)
+	req, err := s.client.NewRequest("POST", u, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	release := new(Release)
+	resp, err := s.client.Do(ctx, req, release)
+	if err != nil {
+		return nil, resp, err
+	}
+	return release, resp, nil
+}
+
+// UpdateReleaseParams are the parameters for ReleasesService.Update.
+// Setting DateReleased finalizes the release.
+type UpdateReleaseParams struct {
+	Projects     []string        `json:"projects,omitempty"`
+	Ref          *string         `json:"ref,omitempty"`
+	URL          *string         `json:"url,omitempty"`
+	DateReleased *time.Time      `json:"dateReleased,omitempty"`
+	Refs         []ReleaseRef    `json:"refs,omitempty"`
+	Commits      []ReleaseCommit `json:"commits,omitempty"`
+}
+
+// Update a release.
+// https://docs.sentry.io/api/releases/update-an-organizations-release/
+func (s *ReleasesService) Update(ctx context.Context, organizationSlug string, version string, params *UpdateReleaseParams) (*Release, *Response, error) {
+	u := fmt.Sprintf("0/organizations/%v/releases/%v/", organizationSlug, url.PathEscape(version))
+	req, err := s.client.NewRequest("PUT", u, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	release := new(Release)
+	resp, err := s.client.Do(ctx, req, release)
+	if err != nil {
+		return nil, resp, err
+	}
+	return release, resp, nil
+}
+
+// SetCommits associates the commits of the given refs with a release, in the
+// same way as `sentry-cli releases set-commits`.
+func (s *ReleasesService) SetCommits(ctx context.Context, organizationSlug string, version string, refs []ReleaseRef) (*Release, *Response, error) {
+	return s.Update(ctx, organizationSlug, version, &UpdateReleaseParams{Refs: refs})
+}
+
+// Delete a release.
+// https://docs.sentry.io/api/releases/delete-an-organizations-release/
+func (s *ReleasesService) Delete(ctx context.Context, organizationSlug string, version string) (*Response, error) {
+	u := fmt.Sprintf("0/organizations/%v/releases/%v/", organizationSlug, url.PathEscape(version))
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
@@ -0,0 +1,286 @@
+package sentry
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReleasesService_List(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/releases/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assertQuery(t, map[string]string{"cursor": "100:1:0", "query": "1.0", "project": "2"}, r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[
+			{
+				"id": 1,
+				"version": "1.0.0",
+				"shortVersion": "1.0.0",
+				"ref": null,
+				"url": null,
+				"dateCreated": "2022-06-01T09:00:00Z",
+				"dateReleased": null,
+				"firstEvent": null,
+				"lastEvent": null,
+				"newGroups": 0,
+				"commitCount": 0,
+				"deployCount": 1,
+				"projects": [
+					{
+						"id": 2,
+						"slug": "pump-station",
+						"name": "Pump Station",
+						"newGroups": 0,
+						"platform": "python",
+						"platforms": ["python"]
+					}
+				]
+			}
+		]`)
+	})
+
+	params := &ListReleasesParams{
+		ListCursorParams: ListCursorParams{Cursor: "100:1:0"},
+		Query:            "1.0",
+		Project:          "2",
+	}
+	ctx := context.Background()
+	releases, _, err := client.Releases.List(ctx, "the-interstellar-jurisdiction", params)
+	assert.NoError(t, err)
+
+	expected := []*Release{
+		{
+			ID:           1,
+			Version:      "1.0.0",
+			ShortVersion: "1.0.0",
+			DateCreated:  mustParseTime("2022-06-01T09:00:00Z"),
+			DeployCount:  1,
+			Projects: []ReleaseProject{
+				{
+					ID:        2,
+					Slug:      "pump-station",
+					Name:      "Pump Station",
+					Platform:  String("python"),
+					Platforms: []string{"python"},
+				},
+			},
+		},
+	}
+	assert.Equal(t, expected, releases)
+}
+
+func TestReleasesService_Get(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/releases/1.0.0/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{
+			"id": 1,
+			"version": "1.0.0",
+			"shortVersion": "1.0.0",
+			"ref": "6ba09a7c53235ee8a8fa5ee4c1ca8ca886e7fdbb",
+			"url": "https://example.com/releases/1.0.0",
+			"dateCreated": "2022-06-01T09:00:00Z",
+			"dateReleased": "2022-06-01T10:00:00Z",
+			"newGroups": 3,
+			"commitCount": 2,
+			"deployCount": 0,
+			"projects": []
+		}`)
+	})
+
+	ctx := context.Background()
+	release, _, err := client.Releases.Get(ctx, "the-interstellar-jurisdiction", "1.0.0")
+	assert.NoError(t, err)
+
+	expected := &Release{
+		ID:           1,
+		Version:      "1.0.0",
+		ShortVersion: "1.0.0",
+		Ref:          String("6ba09a7c53235ee8a8fa5ee4c1ca8ca886e7fdbb"),
+		URL:          String("https://example.com/releases/1.0.0"),
+		DateCreated:  mustParseTime("2022-06-01T09:00:00Z"),
+		DateReleased: Time(mustParseTime("2022-06-01T10:00:00Z")),
+		NewGroups:    3,
+		CommitCount:  2,
+		Projects:     []ReleaseProject{},
+	}
+	assert.Equal(t, expected, release)
+}
+
+func TestReleasesService_Create(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/releases/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "POST", r)
+		assertPostJSON(t, map[string]interface{}{
+			"version":  "1.0.0",
+			"projects": []interface{}{"pump-station"},
+			"refs": []interface{}{
+				map[string]interface{}{
+					"repository":     "getsentry/sentry",
+					"commit":         "6ba09a7c53235ee8a8fa5ee4c1ca8ca886e7fdbb",
+					"previousCommit": "8cb1cd9cf0ff24ee1cc3a9e66ca4a2cb2b0e14e6",
+				},
+			},
+		}, r)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{
+			"id": 1,
+			"version": "1.0.0",
+			"shortVersion": "1.0.0",
+			"dateCreated": "2022-06-01T09:00:00Z",
+			"projects": [{"id": 2, "slug": "pump-station", "name": "Pump Station"}]
+		}`)
+	})
+
+	params := &CreateReleaseParams{
+		Version:  "1.0.0",
+		Projects: []string{"pump-station"},
+		Refs: []ReleaseRef{
+			{
+				Repository:     "getsentry/sentry",
+				Commit:         "6ba09a7c53235ee8a8fa5ee4c1ca8ca886e7fdbb",
+				PreviousCommit: String("8cb1cd9cf0ff24ee1cc3a9e66ca4a2cb2b0e14e6"),
+			},
+		},
+	}
+	ctx := context.Background()
+	release, _, err := client.Releases.Create(ctx, "the-interstellar-jurisdiction", params)
+	assert.NoError(t, err)
+
+	expected := &Release{
+		ID:           1,
+		Version:      "1.0.0",
+		ShortVersion: "1.0.0",
+		DateCreated:  mustParseTime("2022-06-01T09:00:00Z"),
+		Projects: []ReleaseProject{
+			{ID: 2, Slug: "pump-station", Name: "Pump Station"},
+		},
+	}
+	assert.Equal(t, expected, release)
+}
+
+func TestReleasesService_Update(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/releases/1.0.0/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "PUT", r)
+		assertPostJSON(t, map[string]interface{}{
+			"url":          "https://example.com/releases/1.0.0",
+			"dateReleased": "2022-06-01T10:00:00Z",
+		}, r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{
+			"id": 1,
+			"version": "1.0.0",
+			"shortVersion": "1.0.0",
+			"url": "https://example.com/releases/1.0.0",
+			"dateCreated": "2022-06-01T09:00:00Z",
+			"dateReleased": "2022-06-01T10:00:00Z"
+		}`)
+	})
+
+	params := &UpdateReleaseParams{
+		URL:          String("https://example.com/releases/1.0.0"),
+		DateReleased: Time(mustParseTime("2022-06-01T10:00:00Z")),
+	}
+	ctx := context.Background()
+	release, _, err := client.Releases.Update(ctx, "the-interstellar-jurisdiction", "1.0.0", params)
+	assert.NoError(t, err)
+
+	expected := &Release{
+		ID:           1,
+		Version:      "1.0.0",
+		ShortVersion: "1.0.0",
+		URL:          String("https://example.com/releases/1.0.0"),
+		DateCreated:  mustParseTime("2022-06-01T09:00:00Z"),
+		DateReleased: Time(mustParseTime("2022-06-01T10:00:00Z")),
+	}
+	assert.Equal(t, expected, release)
+}
+
+func TestReleasesService_SetCommits(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/releases/1.0.0/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "PUT", r)
+		assertPostJSON(t, map[string]interface{}{
+			"refs": []interface{}{
+				map[string]interface{}{
+					"repository": "getsentry/sentry",
+					"commit":     "6ba09a7c53235ee8a8fa5ee4c1ca8ca886e7fdbb",
+				},
+			},
+		}, r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"id": 1, "version": "1.0.0", "commitCount": 1}`)
+	})
+
+	refs := []ReleaseRef{
+		{
+			Repository: "getsentry/sentry",
+			Commit:     "6ba09a7c53235ee8a8fa5ee4c1ca8ca886e7fdbb",
+		},
+	}
+	ctx := context.Background()
+	release, _, err := client.Releases.SetCommits(ctx, "the-interstellar-jurisdiction", "1.0.0", refs)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, release.CommitCount)
+}
+
+func TestReleasesService_Delete(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/releases/1.0.0/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "DELETE", r)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	_, err := client.Releases.Delete(ctx, "the-interstellar-jurisdiction", "1.0.0")
+	assert.NoError(t, err)
+}
+
+func TestReleasesService_Update_projects(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/releases/1.0.0/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "PUT", r)
+		assertPostJSON(t, map[string]interface{}{
+			"projects": []interface{}{"pump-station", "prime-mover"},
+		}, r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{
+			"id": 1,
+			"version": "1.0.0",
+			"dateCreated": "2022-06-01T09:00:00Z",
+			"projects": [
+				{"id": 2, "slug": "pump-station", "name": "Pump Station"},
+				{"id": 3, "slug": "prime-mover", "name": "Prime Mover"}
+			]
+		}`)
+	})
+
+	params := &UpdateReleaseParams{
+		Projects: []string{"pump-station", "prime-mover"},
+	}
+	ctx := context.Background()
+	release, _, err := client.Releases.Update(ctx, "the-interstellar-jurisdiction", "1.0.0", params)
+	assert.NoError(t, err)
+	assert.Len(t, release.Projects, 2)
+}
@@ -0,0 +1,84 @@
+package sentry
+
+import (
+	"context"
+	"strings"
+)
+
+// APIRoot represents the root of the Sentry API, which describes the
+// authentication of the current request.
+// https://github.com/getsentry/sentry/blob/master/src/sentry/api/endpoints/index.py
+type APIRoot struct {
+	Version string       `json:"version"`
+	Auth    *APIRootAuth `json:"auth"`
+	User    *User        `json:"user"`
+}
+
+// APIRootAuth represents the authentication of the current request.
+type APIRootAuth struct {
+	Scopes []string `json:"scopes"`
+}
+
+// LoadTokenScopes fetches the scopes of the auth token and caches them on the
+// client.
+func (c *Client) LoadTokenScopes(ctx context.Context) ([]string, *Response, error) {
+	req, err := c.NewRequest("GET", "0/", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(APIRoot)
+	resp, err := c.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if root.Auth == nil {
+		// The token was not recognized, so there are no scopes to cache.
+		return nil, resp, nil
+	}
+	scopes := root.Auth.Scopes
+	if scopes == nil {
+		scopes = []string{}
+	}
+
+	c.scopesMu.Lock()
+	defer c.scopesMu.Unlock()
+	c.scopes = scopes
+	return scopes, resp, nil
+}
+
+// TokenScopes returns the scopes cached by LoadTokenScopes. ok is false if
+// they have not been loaded.
+func (c *Client) TokenScopes() (scopes []string, ok bool) {
+	c.scopesMu.Lock()
+	defer c.scopesMu.Unlock()
+	return c.scopes, c.scopes != nil
+}
+
+// HasScope reports whether scopes grant the scope. Scopes of the same
+// resource imply lower access levels, e.g. "project:admin" grants
+// "project:write" and "project:read".
+func HasScope(scopes []string, scope string) bool {
+	resource, level, _ := strings.Cut(scope, ":")
+	for _, s := range scopes {
+		if s == scope {
+			return true
+		}
+		r, l, _ := strings.Cut(s, ":")
+		if r != resource {
+			continue
+		}
+		switch level {
+		case "read":
+			if l == "write" || l == "admin" {
+				return true
+			}
+		case "write":
+			if l == "admin" {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,89 @@
+package sentry
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_LoadTokenScopes(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{
+			"version": "0",
+			"auth": {
+				"scopes": ["project:write", "alerts:read"]
+			},
+			"user": null
+		}`)
+	})
+
+	_, ok := client.TokenScopes()
+	assert.False(t, ok)
+
+	scopes, _, err := client.LoadTokenScopes(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"project:write", "alerts:read"}, scopes)
+
+	cached, ok := client.TokenScopes()
+	assert.True(t, ok)
+	assert.Equal(t, scopes, cached)
+}
+
+func TestClient_LoadTokenScopes_noAuth(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"version": "0", "auth": null, "user": null}`)
+	})
+
+	scopes, _, err := client.LoadTokenScopes(context.Background())
+	assert.NoError(t, err)
+	assert.Nil(t, scopes)
+
+	_, ok := client.TokenScopes()
+	assert.False(t, ok)
+}
+
+func TestHasScope(t *testing.T) {
+	scopes := []string{"project:admin", "alerts:write", "org:read", "project:releases"}
+
+	tests := []struct {
+		scope string
+		want  bool
+	}{
+		{"project:read", true},
+		{"project:write", true},
+		{"project:admin", true},
+		{"project:releases", true},
+		{"alerts:read", true},
+		{"alerts:write", true},
+		{"org:read", true},
+		{"org:write", false},
+		{"member:read", false},
+		{"team:admin", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.scope, func(t *testing.T) {
+			assert.Equal(t, tt.want, HasScope(scopes, tt.scope))
+		})
+	}
+}
+
+func TestHasScope_organizationAuthToken(t *testing.T) {
+	// Organization auth tokens only have the org:ci scope.
+	scopes := []string{"org:ci"}
+
+	assert.True(t, HasScope(scopes, "org:ci"))
+	assert.False(t, HasScope(scopes, "org:read"))
+	assert.False(t, HasScope(scopes, "project:releases"))
+}
@@ -13,10 +13,12 @@ import (
 	"reflect"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/google/go-querystring/query"
 	"github.com/peterhellberg/link"
+	"golang.org/x/sync/singleflight"
 )
 
 const (
@@ -43,6 +45,21 @@ type Client struct {
 	// User agent used when communicating with Sentry.
 	UserAgent string
 
+	// RegionRouting routes requests to organization-scoped endpoints to the
+	// region the organization is hosted in, e.g. https://de.sentry.io/api/.
+	RegionRouting bool
+
+	// TaskPolling configures how async tasks, such as the creation of alert
+	// rules, are polled.
+	TaskPolling TaskPolling
+
+	regionMu      sync.Mutex
+	regionURLs    map[string]*url.URL
+	regionLookups singleflight.Group
+
+	scopesMu sync.Mutex
+	scopes   []string
+
 	// Common struct used by all services.
 	common service
 
@@ -51,6 +68,7 @@ type Client struct {
 	Dashboards               *DashboardsService
 	IssueAlerts              *IssueAlertsService
 	MetricAlerts             *MetricAlertsService
+	NotificationActions      *NotificationActionsService
 	OrganizationCodeMappings *OrganizationCodeMappingsService
 	OrganizationIntegrations *OrganizationIntegrationsService
 	OrganizationMembers      *OrganizationMembersService
@@ -59,9 +77,13 @@ type Client struct {
 	ProjectKeys              *ProjectKeysService
 	ProjectOwnerships        *ProjectOwnershipsService
 	ProjectPlugins           *ProjectPluginsService
+	ProjectSymbolSources     *ProjectSymbolSourcesService
 	Projects                 *ProjectsService
 	ProjectFilter            *ProjectFilterService
 	ReleaseDeployments       *ReleaseDeploymentsService
+	Releases                 *ReleasesService
+	SpikeProtections         *SpikeProtectionsService
+	TeamMembers              *TeamMembersService
 	Teams                    *TeamsService
 	Pagerduty                *PagerdutyService
 }
@@ -79,15 +101,17 @@ func NewClient(httpClient *http.Client) *Client {
 	baseURL, _ := url.Parse(defaultBaseURL)
 
 	c := &Client{
-		client:    httpClient,
-		BaseURL:   baseURL,
-		UserAgent: userAgent,
+		client:      httpClient,
+		BaseURL:     baseURL,
+		UserAgent:   userAgent,
+		TaskPolling: DefaultTaskPolling,
 	}
 	c.common.client = c
 	c.DashboardWidgets = (*DashboardWidgetsService)(&c.common)
 	c.Dashboards = (*DashboardsService)(&c.common)
 	c.IssueAlerts = (*IssueAlertsService)(&c.common)
 	c.MetricAlerts = (*MetricAlertsService)(&c.common)
+	c.NotificationActions = (*NotificationActionsService)(&c.common)
 	c.OrganizationCodeMappings = (*OrganizationCodeMappingsService)(&c.common)
 	c.OrganizationIntegrations = (*OrganizationIntegrationsService)(&c.common)
 	c.OrganizationMembers = (*OrganizationMembersService)(&c.common)
@@ -97,8 +121,12 @@ func NewClient(httpClient *http.Client) *Client {
 	c.ProjectKeys = (*ProjectKeysService)(&c.common)
 	c.ProjectOwnerships = (*ProjectOwnershipsService)(&c.common)
 	c.ProjectPlugins = (*ProjectPluginsService)(&c.common)
+	c.ProjectSymbolSources = (*ProjectSymbolSourcesService)(&c.common)
 	c.Projects = (*ProjectsService)(&c.common)
 	c.ReleaseDeployments = (*ReleaseDeploymentsService)(&c.common)
+	c.Releases = (*ReleasesService)(&c.common)
+	c.SpikeProtections = (*SpikeProtectionsService)(&c.common)
+	c.TeamMembers = (*TeamMembersService)(&c.common)
 	c.Teams = (*TeamsService)(&c.common)
 	c.Pagerduty = (*PagerdutyService)(&c.common)
 	return c
@@ -242,6 +270,19 @@ func (c *Client) BareDo(ctx context.Context, req *http.Request) (*Response, erro
 	if ctx == nil {
 		return nil, errNonNilContext
 	}
+	if c.RegionRouting {
+		var err error
+		req, err = c.routeToRegion(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return c.bareDo(ctx, req)
+}
+
+func (c *Client) bareDo(ctx context.Context, req *http.Request) (*Response, error) {
+	req = req.WithContext(ctx)
 
 	resp, err := c.client.Do(req)
 	if err != nil {
@@ -349,12 +390,15 @@ func CheckResponse(r *http.Response) error {
 	}
 
 	errorResponse := &ErrorResponse{Response: r}
+	var fieldErrors map[string][]string
 	data, err := ioutil.ReadAll(r.Body)
 	if err == nil && data != nil {
 		apiError := new(APIError)
 		json.Unmarshal(data, apiError)
 		if apiError.Empty() {
 			errorResponse.Detail = strings.TrimSpace(string(data))
+		} else if fieldErrors = apiError.FieldErrors(); fieldErrors != nil {
+			errorResponse.Detail = formatFieldErrors(fieldErrors)
 		} else {
 			errorResponse.Detail = apiError.Detail()
 		}
@@ -370,6 +414,14 @@ func CheckResponse(r *http.Response) error {
 			Response: errorResponse.Response,
 			Detail:   errorResponse.Detail,
 		}
+	case r.StatusCode == http.StatusBadRequest:
+		return &ValidationError{ErrorResponse: errorResponse, FieldErrors: fieldErrors}
+	case r.StatusCode == http.StatusUnauthorized || r.StatusCode == http.StatusForbidden:
+		return &PermissionError{ErrorResponse: errorResponse}
+	case r.StatusCode == http.StatusNotFound:
+		return &NotFoundError{ErrorResponse: errorResponse}
+	case r.StatusCode == http.StatusConflict:
+		return &ConflictError{ErrorResponse: errorResponse}
 	}
 
 	return errorResponse
@@ -443,6 +495,9 @@ func InterfaceNumber(v json.Number) *interface{} {
 	return &i
 }
 
+// JSONNumber returns a pointer to the json.Number value passed in.
+func JSONNumber(v json.Number) *json.Number { return &v }
+
 // StringValue returns the value of the string pointer passed in or
 // "" if the pointer is nil.
 func StringValue(v *string) string {
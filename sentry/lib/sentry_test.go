@@ -3,6 +3,7 @@ package sentry
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"net/http"
@@ -21,6 +22,7 @@ func setup() (client *Client, mux *http.ServeMux, serverURL string, teardown fun
 	client = NewClient(nil)
 	url, _ := url.Parse(server.URL + "/api/")
 	client.BaseURL = url
+	client.TaskPolling = TaskPolling{Interval: time.Millisecond, Timeout: time.Second}
 	return client, mux, server.URL, server.Close
 }
 
@@ -207,7 +209,7 @@ func TestDo_httpErrorPlainText(t *testing.T) {
 	ctx := context.Background()
 	resp, err := client.Do(ctx, req, nil)
 
-	assert.Equal(t, &ErrorResponse{Response: resp.Response, Detail: "Bad Request"}, err)
+	assert.Equal(t, &ValidationError{ErrorResponse: &ErrorResponse{Response: resp.Response, Detail: "Bad Request"}}, err)
 	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
 }
 
@@ -226,7 +228,7 @@ func TestDo_apiError(t *testing.T) {
 	ctx := context.Background()
 	resp, err := client.Do(ctx, req, nil)
 
-	assert.Equal(t, &ErrorResponse{Response: resp.Response, Detail: "API error message"}, err)
+	assert.Equal(t, &ValidationError{ErrorResponse: &ErrorResponse{Response: resp.Response, Detail: "API error message"}}, err)
 	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
 }
 
@@ -245,7 +247,7 @@ func TestDo_apiError_noDetail(t *testing.T) {
 	ctx := context.Background()
 	resp, err := client.Do(ctx, req, nil)
 
-	assert.Equal(t, &ErrorResponse{Response: resp.Response, Detail: "API error message"}, err)
+	assert.Equal(t, &ValidationError{ErrorResponse: &ErrorResponse{Response: resp.Response, Detail: "API error message"}}, err)
 	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
 }
 
@@ -287,6 +289,64 @@ func TestCheckResponse(t *testing.T) {
 
 }
 
+func TestDo_validationError(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "POST", r)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{
+			"name": ["This field is required."],
+			"triggers": [{"alertThreshold": ["Must be a number."]}],
+			"slug": "Enter a valid slug."
+		}`)
+	})
+
+	req, _ := client.NewRequest("POST", ".", map[string]string{})
+	ctx := context.Background()
+	_, err := client.Do(ctx, req, nil)
+
+	var validationErr *ValidationError
+	assert.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, map[string][]string{
+		"name":                      {"This field is required."},
+		"slug":                      {"Enter a valid slug."},
+		"triggers.0.alertThreshold": {"Must be a number."},
+	}, validationErr.FieldErrors)
+	assert.Equal(t, "name: This field is required.; slug: Enter a valid slug.; triggers.0.alertThreshold: Must be a number.", validationErr.Detail)
+
+	var errorResponse *ErrorResponse
+	assert.ErrorAs(t, err, &errorResponse)
+}
+
+func TestCheckResponse_statusCodes(t *testing.T) {
+	testcases := []struct {
+		statusCode int
+		check      func(err error) bool
+	}{
+		{http.StatusUnauthorized, func(err error) bool { var e *PermissionError; return errors.As(err, &e) }},
+		{http.StatusForbidden, func(err error) bool { var e *PermissionError; return errors.As(err, &e) }},
+		{http.StatusNotFound, func(err error) bool { var e *NotFoundError; return errors.As(err, &e) }},
+		{http.StatusConflict, func(err error) bool { var e *ConflictError; return errors.As(err, &e) }},
+		{http.StatusInternalServerError, func(err error) bool { _, ok := err.(*ErrorResponse); return ok }},
+	}
+	for _, tc := range testcases {
+		t.Run(http.StatusText(tc.statusCode), func(t *testing.T) {
+			res := &http.Response{
+				Request:    &http.Request{},
+				StatusCode: tc.statusCode,
+				Body:       ioutil.NopCloser(strings.NewReader(`{"detail": "Error message"}`)),
+			}
+
+			err := CheckResponse(res)
+			assert.True(t, tc.check(err), "unexpected error type %T", err)
+			assert.ErrorIs(t, err, &ErrorResponse{Response: res, Detail: "Error message"})
+		})
+	}
+}
+
 func TestCheckResponse_rateLimit(t *testing.T) {
 	testcases := []struct {
 		description string
@@ -0,0 +1,42 @@
+package sentry
+
+import (
+	"context"
+	"fmt"
+)
+
+// SpikeProtectionsService provides methods for accessing Sentry spike protection API endpoints.
+// https://docs.sentry.io/api/projects/
+type SpikeProtectionsService service
+
+// SpikeProtectionParams are the parameters for SpikeProtectionsService.Enable and
+// SpikeProtectionsService.Disable.
+type SpikeProtectionParams struct {
+	// Projects is the list of project slugs to update. Use "$all" for all
+	// projects of the organization.
+	Projects []string `json:"projects"`
+}
+
+// Enable spike protection for projects of an organization.
+// https://docs.sentry.io/api/projects/enable-spike-protection/
+func (s *SpikeProtectionsService) Enable(ctx context.Context, organizationSlug string, params *SpikeProtectionParams) (*Response, error) {
+	u := fmt.Sprintf("0/organizations/%v/spike-protections/", organizationSlug)
+	req, err := s.client.NewRequest("POST", u, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// Disable spike protection for projects of an organization.
+// https://docs.sentry.io/api/projects/disable-spike-protection/
+func (s *SpikeProtectionsService) Disable(ctx context.Context, organizationSlug string, params *SpikeProtectionParams) (*Response, error) {
+	u := fmt.Sprintf("0/organizations/%v/spike-protections/", organizationSlug)
+	req, err := s.client.NewRequest("DELETE", u, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
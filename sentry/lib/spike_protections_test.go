@@ -0,0 +1,49 @@
+package sentry
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSpikeProtectionsService_Enable(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/spike-protections/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "POST", r)
+		assertPostJSON(t, map[string]interface{}{
+			"projects": []interface{}{"pump-station", "prime-mover"},
+		}, r)
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	params := &SpikeProtectionParams{
+		Projects: []string{"pump-station", "prime-mover"},
+	}
+	ctx := context.Background()
+	_, err := client.SpikeProtections.Enable(ctx, "the-interstellar-jurisdiction", params)
+	assert.NoError(t, err)
+}
+
+func TestSpikeProtectionsService_Disable(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/spike-protections/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "DELETE", r)
+		assertPostJSON(t, map[string]interface{}{
+			"projects": []interface{}{"$all"},
+		}, r)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	params := &SpikeProtectionParams{
+		Projects: []string{"$all"},
+	}
+	ctx := context.Background()
+	_, err := client.SpikeProtections.Disable(ctx, "the-interstellar-jurisdiction", params)
+	assert.NoError(t, err)
+}
@@ -0,0 +1,93 @@
+package sentry
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// TaskPolling configures how the status of an async task, such as the
+// creation of an alert rule, is polled.
+type TaskPolling struct {
+	// Interval is the wait time before the first poll. Zero means the
+	// interval of DefaultTaskPolling.
+	Interval time.Duration
+	// MaxInterval bounds the wait time between polls. Zero means no bound.
+	MaxInterval time.Duration
+	// Backoff multiplies the wait time after each poll. Values below 1 poll
+	// at a fixed interval.
+	Backoff float64
+	// Timeout is the maximum total wait time. Zero means polling continues
+	// until the context is done.
+	Timeout time.Duration
+}
+
+// DefaultTaskPolling is the task polling of new clients.
+var DefaultTaskPolling = TaskPolling{
+	Interval:    2 * time.Second,
+	MaxInterval: 15 * time.Second,
+	Backoff:     1.5,
+	Timeout:     2 * time.Minute,
+}
+
+// ErrTaskTimeout is returned when an async task did not finish within the
+// timeout of the task polling.
+var ErrTaskTimeout = errors.New("timed out waiting for the task to finish")
+
+type taskPollingKey struct{}
+
+// WithTaskPolling returns a context which overrides the task polling of the
+// client for the requests made with it.
+func WithTaskPolling(ctx context.Context, polling TaskPolling) context.Context {
+	return context.WithValue(ctx, taskPollingKey{}, polling)
+}
+
+func (c *Client) taskPollingFor(ctx context.Context) TaskPolling {
+	if polling, ok := ctx.Value(taskPollingKey{}).(TaskPolling); ok {
+		return polling
+	}
+	return c.TaskPolling
+}
+
+// pollTask calls poll until it reports the task as done or returns an error.
+// It returns the error of the context as soon as the context is done, and
+// ErrTaskTimeout once the timeout of the task polling has passed.
+func (c *Client) pollTask(ctx context.Context, poll func(ctx context.Context) (bool, error)) error {
+	polling := c.taskPollingFor(ctx)
+
+	var deadline <-chan time.Time
+	if polling.Timeout > 0 {
+		timer := time.NewTimer(polling.Timeout)
+		defer timer.Stop()
+		deadline = timer.C
+	}
+
+	interval := polling.Interval
+	if interval <= 0 {
+		interval = DefaultTaskPolling.Interval
+	}
+	for {
+		wait := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			wait.Stop()
+			return ctx.Err()
+		case <-deadline:
+			wait.Stop()
+			return ErrTaskTimeout
+		case <-wait.C:
+		}
+
+		done, err := poll(ctx)
+		if err != nil || done {
+			return err
+		}
+
+		if polling.Backoff > 1 {
+			interval = time.Duration(float64(interval) * polling.Backoff)
+		}
+		if polling.MaxInterval > 0 && interval > polling.MaxInterval {
+			interval = polling.MaxInterval
+		}
+	}
+}
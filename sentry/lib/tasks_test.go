@@ -0,0 +1,104 @@
+package sentry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_pollTask(t *testing.T) {
+	client := NewClient(nil)
+	client.TaskPolling = TaskPolling{
+		Interval:    time.Millisecond,
+		MaxInterval: 4 * time.Millisecond,
+		Backoff:     2,
+		Timeout:     time.Second,
+	}
+
+	var polls []time.Time
+	err := client.pollTask(context.Background(), func(ctx context.Context) (bool, error) {
+		polls = append(polls, time.Now())
+		return len(polls) == 4, nil
+	})
+	require.NoError(t, err)
+	assert.Len(t, polls, 4)
+}
+
+func TestClient_pollTask_error(t *testing.T) {
+	client := NewClient(nil)
+	client.TaskPolling = TaskPolling{Interval: time.Millisecond}
+
+	pollErr := errors.New("failed")
+	err := client.pollTask(context.Background(), func(ctx context.Context) (bool, error) {
+		return false, pollErr
+	})
+	assert.Equal(t, pollErr, err)
+}
+
+func TestClient_pollTask_timeout(t *testing.T) {
+	client := NewClient(nil)
+	client.TaskPolling = TaskPolling{Interval: time.Millisecond, Timeout: 20 * time.Millisecond}
+
+	err := client.pollTask(context.Background(), func(ctx context.Context) (bool, error) {
+		return false, nil
+	})
+	assert.ErrorIs(t, err, ErrTaskTimeout)
+}
+
+func TestClient_pollTask_canceled(t *testing.T) {
+	client := NewClient(nil)
+	client.TaskPolling = TaskPolling{Interval: time.Hour}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(10*time.Millisecond, cancel)
+
+	start := time.Now()
+	err := client.pollTask(ctx, func(ctx context.Context) (bool, error) {
+		return false, nil
+	})
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Less(t, time.Since(start), time.Second)
+}
+
+func TestClient_pollTask_zeroInterval(t *testing.T) {
+	client := NewClient(nil)
+	client.TaskPolling = TaskPolling{}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	var polls int32
+	err := client.pollTask(ctx, func(ctx context.Context) (bool, error) {
+		atomic.AddInt32(&polls, 1)
+		return false, nil
+	})
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Zero(t, atomic.LoadInt32(&polls))
+}
+
+func TestWithTaskPolling(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var polls int32
+	mux.HandleFunc("/api/0/projects/the-interstellar-jurisdiction/pump-station/alert-rule-task/fakeuuid/", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&polls, 1)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"status": "pending", "alertRule": null, "error": null}`)
+	})
+
+	ctx := WithTaskPolling(context.Background(), TaskPolling{
+		Interval: time.Millisecond,
+		Timeout:  50 * time.Millisecond,
+	})
+	_, _, err := client.MetricAlerts.getMetricAlertFromMetricAlertTaskDetail(ctx, "the-interstellar-jurisdiction", "pump-station", "fakeuuid")
+	assert.ErrorIs(t, err, ErrTaskTimeout)
+	assert.Greater(t, atomic.LoadInt32(&polls), int32(1))
+}
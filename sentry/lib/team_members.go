@@ -0,0 +1,77 @@
+package sentry
+
+import (
+	"context"
+	"fmt"
+)
+
+const (
+	TeamRoleContributor string = "contributor"
+	TeamRoleAdmin       string = "admin"
+)
+
+// TeamMember represents the membership of an organization member in a team.
+type TeamMember struct {
+	IsActive *bool   `json:"isActive"`
+	TeamRole *string `json:"teamRole"`
+}
+
+// TeamMembersService provides methods for accessing Sentry team membership API endpoints.
+// https://docs.sentry.io/api/teams/
+type TeamMembersService service
+
+// Create adds an organization member to a team.
+// https://docs.sentry.io/api/teams/add-an-organization-member-to-a-team/
+func (s *TeamMembersService) Create(ctx context.Context, organizationSlug string, memberID string, teamSlug string) (*Team, *Response, error) {
+	u := fmt.Sprintf("0/organizations/%v/members/%v/teams/%v/", organizationSlug, memberID, teamSlug)
+	req, err := s.client.NewRequest("POST", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	team := new(Team)
+	resp, err := s.client.Do(ctx, req, team)
+	if err != nil {
+		return nil, resp, err
+	}
+	return team, resp, nil
+}
+
+// UpdateTeamMemberParams are the parameters for TeamMembersService.Update.
+type UpdateTeamMemberParams struct {
+	TeamRole *string `json:"teamRole,omitempty"`
+}
+
+// Update the role of an organization member in a team.
+// https://docs.sentry.io/api/teams/update-an-organization-members-team-role/
+func (s *TeamMembersService) Update(ctx context.Context, organizationSlug string, memberID string, teamSlug string, params *UpdateTeamMemberParams) (*TeamMember, *Response, error) {
+	u := fmt.Sprintf("0/organizations/%v/members/%v/teams/%v/", organizationSlug, memberID, teamSlug)
+	req, err := s.client.NewRequest("PUT", u, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	member := new(TeamMember)
+	resp, err := s.client.Do(ctx, req, member)
+	if err != nil {
+		return nil, resp, err
+	}
+	return member, resp, nil
+}
+
+// Delete removes an organization member from a team.
+// https://docs.sentry.io/api/teams/delete-an-organization-member-from-a-team/
+func (s *TeamMembersService) Delete(ctx context.Context, organizationSlug string, memberID string, teamSlug string) (*Team, *Response, error) {
+	u := fmt.Sprintf("0/organizations/%v/members/%v/teams/%v/", organizationSlug, memberID, teamSlug)
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	team := new(Team)
+	resp, err := s.client.Do(ctx, req, team)
+	if err != nil {
+		return nil, resp, err
+	}
+	return team, resp, nil
+}
@@ -0,0 +1,101 @@
+package sentry
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTeamMembersService_Create(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/members/1/teams/ancient-gabelers/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "POST", r)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{
+			"id": "3",
+			"slug": "ancient-gabelers",
+			"name": "Ancient Gabelers",
+			"dateCreated": "2017-07-18T19:29:46.305Z",
+			"isMember": false,
+			"teamRole": null,
+			"hasAccess": true,
+			"isPending": false,
+			"memberCount": 1
+		}`)
+	})
+
+	ctx := context.Background()
+	team, _, err := client.TeamMembers.Create(ctx, "the-interstellar-jurisdiction", "1", "ancient-gabelers")
+	assert.NoError(t, err)
+
+	expected := &Team{
+		ID:          String("3"),
+		Slug:        String("ancient-gabelers"),
+		Name:        String("Ancient Gabelers"),
+		DateCreated: Time(mustParseTime("2017-07-18T19:29:46.305Z")),
+		IsMember:    Bool(false),
+		HasAccess:   Bool(true),
+		IsPending:   Bool(false),
+		MemberCount: Int(1),
+	}
+	assert.Equal(t, expected, team)
+}
+
+func TestTeamMembersService_Update(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/members/1/teams/ancient-gabelers/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "PUT", r)
+		assertPostJSON(t, map[string]interface{}{
+			"teamRole": "admin",
+		}, r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"isActive": true, "teamRole": "admin"}`)
+	})
+
+	params := &UpdateTeamMemberParams{
+		TeamRole: String(TeamRoleAdmin),
+	}
+	ctx := context.Background()
+	member, _, err := client.TeamMembers.Update(ctx, "the-interstellar-jurisdiction", "1", "ancient-gabelers", params)
+	assert.NoError(t, err)
+
+	expected := &TeamMember{
+		IsActive: Bool(true),
+		TeamRole: String("admin"),
+	}
+	assert.Equal(t, expected, member)
+}
+
+func TestTeamMembersService_Delete(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/members/1/teams/ancient-gabelers/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "DELETE", r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{
+			"id": "3",
+			"slug": "ancient-gabelers",
+			"name": "Ancient Gabelers",
+			"dateCreated": "2017-07-18T19:29:46.305Z",
+			"isMember": false,
+			"teamRole": null,
+			"hasAccess": true,
+			"isPending": false,
+			"memberCount": 0
+		}`)
+	})
+
+	ctx := context.Background()
+	team, _, err := client.TeamMembers.Delete(ctx, "the-interstellar-jurisdiction", "1", "ancient-gabelers")
+	assert.NoError(t, err)
+	assert.Equal(t, Int(0), team.MemberCount)
+}
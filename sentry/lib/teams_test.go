@@ -15,6 +15,7 @@ func TestTeamsService_List(t *testing.T) {
 
 	mux.HandleFunc("/api/0/organizations/the-interstellar-jurisdiction/teams/", func(w http.ResponseWriter, r *http.Request) {
 		assertMethod(t, "GET", r)
+		assertQuery(t, map[string]string{"cursor": "100:1:0"}, r)
 		w.Header().Set("Content-Type", "application/json")
 		fmt.Fprint(w, `[
 			{
@@ -118,7 +119,9 @@ func TestTeamsService_List(t *testing.T) {
 	})
 
 	ctx := context.Background()
-	teams, _, err := client.Teams.List(ctx, "the-interstellar-jurisdiction")
+	teams, _, err := client.Teams.List(ctx, "the-interstellar-jurisdiction", &ListCursorParams{
+		Cursor: "100:1:0",
+	})
 	assert.NoError(t, err)
 
 	expected := []*Team{
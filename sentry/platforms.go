@@ -0,0 +1,113 @@
+package sentry
+
+import (
+	_ "embed"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+)
+
+//go:generate go run ../tools/platforms -output platforms.txt
+
+//go:embed platforms.txt
+var platformsFile string
+
+// platforms are the platforms known to Sentry, in alphabetical order.
+var platforms = parsePlatforms(platformsFile)
+
+// remotePlatformValidationEnv enables looking up platforms which are not
+// embedded in the provider in the Sentry documentation.
+const remotePlatformValidationEnv = "SENTRY_REMOTE_PLATFORM_VALIDATION"
+
+func parsePlatforms(s string) []string {
+	var platforms []string
+	for _, line := range strings.Split(s, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		platforms = append(platforms, line)
+	}
+	return platforms
+}
+
+func isKnownPlatform(platform string) bool {
+	return containsString(platforms, platform)
+}
+
+// isRemotePlatform checks whether the Sentry documentation has a page for the platform.
+func isRemotePlatform(platform string) (bool, error) {
+	urls := []string{
+		fmt.Sprintf("https://docs.sentry.io/_platforms/%s.json", platform),
+		fmt.Sprintf(
+			"https://docs.sentry.io/_platforms/%s.json",
+			strings.Replace(platform, "-", "/", 1),
+		),
+	}
+
+	var lastErr error
+	for _, url := range urls {
+		resp, err := http.Get(url)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		resp.Body.Close()
+		if resp.StatusCode == http.StatusOK {
+			return true, nil
+		}
+	}
+	return false, lastErr
+}
+
+func remotePlatformValidationEnabled() bool {
+	enabled, _ := strconv.ParseBool(os.Getenv(remotePlatformValidationEnv))
+	return enabled
+}
+
+// suggestPlatform returns the known platform closest to the given one, or an
+// empty string if none is close enough to be a likely typo.
+func suggestPlatform(platform string) string {
+	maxDistance := len(platform)/3 + 1
+
+	var suggestion string
+	best := maxDistance + 1
+	for _, p := range platforms {
+		if d := levenshtein(platform, p); d < best {
+			suggestion, best = p, d
+		}
+	}
+	return suggestion
+}
+
+// levenshtein returns the edit distance between two strings.
+func levenshtein(a, b string) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = minInt(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(b)]
+}
+
+func minInt(v int, vs ...int) int {
+	for _, x := range vs {
+		if x < v {
+			v = x
+		}
+	}
+	return v
+}
@@ -2,9 +2,13 @@ package sentry
 
 import (
 	"context"
+	"fmt"
+	"regexp"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func init() {
@@ -32,7 +36,109 @@ func NewProvider(version string) func() *schema.Provider {
 						"Sentry On-Premise. The value can be sourced from the `SENTRY_BASE_URL` environment variable.",
 					Type:        schema.TypeString,
 					Optional:    true,
-					DefaultFunc: schema.EnvDefaultFunc("SENTRY_BASE_URL", "https://sentry.io/api/"),
+					DefaultFunc: schema.EnvDefaultFunc("SENTRY_BASE_URL", defaultBaseURL),
+				},
+				"region": {
+					Description: "The sentry.io region to send all requests to, e.g. `us` or `de`. By default, requests to " +
+						"an organization are routed to the region the organization is hosted in. The value can only be " +
+						"used with the default `base_url` and can be sourced from the `SENTRY_REGION` environment variable.",
+					Type:         schema.TypeString,
+					Optional:     true,
+					DefaultFunc:  schema.EnvDefaultFunc("SENTRY_REGION", nil),
+					ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[a-z0-9-]+$`), "must be a region name such as `us` or `de`"),
+				},
+				"max_retries": {
+					Description: "The maximum number of retries of a failed or rate limited request. The default value is `4`. " +
+						"The value can be sourced from the `SENTRY_MAX_RETRIES` environment variable.",
+					Type:         schema.TypeInt,
+					Optional:     true,
+					DefaultFunc:  schema.EnvDefaultFunc("SENTRY_MAX_RETRIES", 4),
+					ValidateFunc: validation.IntAtLeast(0),
+				},
+				"min_backoff": {
+					Description: "The minimum time to wait before retrying a request, e.g. `1s`. The default value is `1s`. " +
+						"The value can be sourced from the `SENTRY_MIN_BACKOFF` environment variable.",
+					Type:         schema.TypeString,
+					Optional:     true,
+					DefaultFunc:  schema.EnvDefaultFunc("SENTRY_MIN_BACKOFF", "1s"),
+					ValidateFunc: validateDuration,
+				},
+				"max_backoff": {
+					Description: "The maximum time to wait before retrying a request, e.g. `30s`. The default value is `30s`. " +
+						"Waits for a rate limit to reset are not capped. The value can be sourced from the " +
+						"`SENTRY_MAX_BACKOFF` environment variable.",
+					Type:         schema.TypeString,
+					Optional:     true,
+					DefaultFunc:  schema.EnvDefaultFunc("SENTRY_MAX_BACKOFF", "30s"),
+					ValidateFunc: validateDuration,
+				},
+				"request_timeout": {
+					Description: "The maximum time a single request attempt may take, e.g. `1m`. By default, requests " +
+						"do not time out. The value can be sourced from the `SENTRY_REQUEST_TIMEOUT` environment variable.",
+					Type:         schema.TypeString,
+					Optional:     true,
+					DefaultFunc:  schema.EnvDefaultFunc("SENTRY_REQUEST_TIMEOUT", nil),
+					ValidateFunc: validateDuration,
+				},
+				"log_http_bodies": {
+					Description: "Include the bodies of requests to and responses from the Sentry API in the trace logs " +
+						"(`TF_LOG=TRACE`). Secrets are redacted, but bodies may still contain sensitive data. " +
+						"The value can be sourced from the `SENTRY_LOG_HTTP_BODIES` environment variable.",
+					Type:        schema.TypeBool,
+					Optional:    true,
+					DefaultFunc: schema.EnvDefaultFunc("SENTRY_LOG_HTTP_BODIES", false),
+				},
+				"skip_scope_check": {
+					Description: "Skip looking up the scopes of the authentication token. By default, the provider checks " +
+						"that the token has the scopes a resource needs before calling Sentry. The value can be sourced " +
+						"from the `SENTRY_SKIP_SCOPE_CHECK` environment variable.",
+					Type:        schema.TypeBool,
+					Optional:    true,
+					DefaultFunc: schema.EnvDefaultFunc("SENTRY_SKIP_SCOPE_CHECK", false),
+				},
+				"max_concurrent_requests": {
+					Description: "The maximum number of concurrent requests. By default, the concurrency limit reported " +
+						"by Sentry is used. The value can be sourced from the `SENTRY_MAX_CONCURRENT_REQUESTS` environment variable.",
+					Type:         schema.TypeInt,
+					Optional:     true,
+					DefaultFunc:  schema.EnvDefaultFunc("SENTRY_MAX_CONCURRENT_REQUESTS", nil),
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+				"alert_task_poll_interval": {
+					Description: "The time to wait before first checking the status of an alert that Sentry creates " +
+						"asynchronously, e.g. `2s`. The default value is `2s`. The value can be sourced from the " +
+						"`SENTRY_ALERT_TASK_POLL_INTERVAL` environment variable.",
+					Type:         schema.TypeString,
+					Optional:     true,
+					DefaultFunc:  schema.EnvDefaultFunc("SENTRY_ALERT_TASK_POLL_INTERVAL", "2s"),
+					ValidateFunc: validateDuration,
+				},
+				"alert_task_poll_max_interval": {
+					Description: "The maximum time to wait between checks of the status of an alert that Sentry creates " +
+						"asynchronously, e.g. `15s`. The default value is `15s`. The value can be sourced from the " +
+						"`SENTRY_ALERT_TASK_POLL_MAX_INTERVAL` environment variable.",
+					Type:         schema.TypeString,
+					Optional:     true,
+					DefaultFunc:  schema.EnvDefaultFunc("SENTRY_ALERT_TASK_POLL_MAX_INTERVAL", "15s"),
+					ValidateFunc: validateDuration,
+				},
+				"alert_task_poll_backoff": {
+					Description: "The factor the wait time between checks of the status of an alert that Sentry creates " +
+						"asynchronously grows by after each check. `1` checks at a fixed interval. The default value is " +
+						"`1.5`. The value can be sourced from the `SENTRY_ALERT_TASK_POLL_BACKOFF` environment variable.",
+					Type:         schema.TypeFloat,
+					Optional:     true,
+					DefaultFunc:  schema.EnvDefaultFunc("SENTRY_ALERT_TASK_POLL_BACKOFF", 1.5),
+					ValidateFunc: validation.FloatAtLeast(1),
+				},
+				"alert_task_timeout": {
+					Description: "The maximum time to wait for an alert that Sentry creates asynchronously, e.g. `2m`. " +
+						"By default, the provider waits until the create or update timeout of the resource has passed. " +
+						"The value can be sourced from the `SENTRY_ALERT_TASK_TIMEOUT` environment variable.",
+					Type:         schema.TypeString,
+					Optional:     true,
+					DefaultFunc:  schema.EnvDefaultFunc("SENTRY_ALERT_TASK_TIMEOUT", nil),
+					ValidateFunc: validateDuration,
 				},
 			},
 
@@ -41,28 +147,50 @@ func NewProvider(version string) func() *schema.Provider {
 				"sentry_issue_alert":                    resourceSentryIssueAlert(),
 				"sentry_key":                            resourceSentryKey(),
 				"sentry_metric_alert":                   resourceSentryMetricAlert(),
+				"sentry_notification_action":            resourceSentryNotificationAction(),
 				"sentry_organization_code_mapping":      resourceSentryOrganizationCodeMapping(),
 				"sentry_organization_member":            resourceSentryOrganizationMember(),
 				"sentry_organization_repository_github": resourceSentryOrganizationRepositoryGithub(),
 				"sentry_organization":                   resourceSentryOrganization(),
+				"sentry_organization_settings":          resourceSentryOrganizationSettings(),
 				"sentry_plugin":                         resourceSentryPlugin(),
 				"sentry_project":                        resourceSentryProject(),
+				"sentry_project_inbound_data_filter":    resourceSentryProjectInboundDataFilter(),
+				"sentry_project_ownership":              resourceSentryProjectOwnership(),
+				"sentry_project_spike_protection":       resourceSentryProjectSpikeProtection(),
+				"sentry_project_symbol_source":          resourceSentryProjectSymbolSource(),
+				"sentry_release":                        resourceSentryRelease(),
+				"sentry_release_deployment":             resourceSentryReleaseDeployment(),
 				"sentry_rule":                           resourceSentryRule(),
 				"sentry_team":                           resourceSentryTeam(),
+				"sentry_team_member":                    resourceSentryTeamMember(),
 			},
 
 			DataSourcesMap: map[string]*schema.Resource{
-				"sentry_dashboard":                dataSourceSentryDashboard(),
-				"sentry_issue_alert":              dataSourceSentryIssueAlertSentryIssueAlert(),
-				"sentry_key":                      dataSourceSentryKey(),
-				"sentry_metric_alert":             dataSourceSentryMetricAlert(),
-				"sentry_organization":             dataSourceSentryOrganization(),
-				"sentry_organization_integration": dataSourceSentryOrganizationIntegration(),
-				"sentry_team":                     dataSourceSentryTeam(),
-				"sentry_pagerduty_integration":    dataSourcePagerdutyIntegration(),
+				"sentry_dashboard":                 dataSourceSentryDashboard(),
+				"sentry_issue_alert":               dataSourceSentryIssueAlertSentryIssueAlert(),
+				"sentry_issue_alert_configuration": dataSourceSentryIssueAlertConfiguration(),
+				"sentry_key":                       dataSourceSentryKey(),
+				"sentry_metric_alert":              dataSourceSentryMetricAlert(),
+				"sentry_organization":              dataSourceSentryOrganization(),
+				"sentry_organization_integration":  dataSourceSentryOrganizationIntegration(),
+				"sentry_organization_member":       dataSourceSentryOrganizationMember(),
+				"sentry_project":                   dataSourceSentryProject(),
+				"sentry_projects":                  dataSourceSentryProjects(),
+				"sentry_releases":                  dataSourceSentryReleases(),
+				"sentry_team":                      dataSourceSentryTeam(),
+				"sentry_pagerduty_integration":     dataSourcePagerdutyIntegration(),
 			},
 		}
 
+		for name, r := range p.ResourcesMap {
+			setDefaultTimeouts(r)
+			requireScopes(name, r, resourceScopes[name])
+		}
+		for name, r := range p.DataSourcesMap {
+			requireScopes(name, r, dataSourceScopes[name])
+		}
+
 		p.ConfigureContextFunc = configure(version, p)
 
 		return p
@@ -72,10 +200,68 @@ func NewProvider(version string) func() *schema.Provider {
 func configure(version string, p *schema.Provider) func(context.Context, *schema.ResourceData) (interface{}, diag.Diagnostics) {
 	return func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
 		config := Config{
-			UserAgent: p.UserAgent("terraform-provider-sentry", version),
-			Token:     d.Get("token").(string),
-			BaseURL:   d.Get("base_url").(string),
+			UserAgent:             p.UserAgent("terraform-provider-sentry", version),
+			Token:                 d.Get("token").(string),
+			BaseURL:               d.Get("base_url").(string),
+			Region:                d.Get("region").(string),
+			MaxRetries:            d.Get("max_retries").(int),
+			MaxConcurrentRequests: d.Get("max_concurrent_requests").(int),
+			LogHTTPBodies:         d.Get("log_http_bodies").(bool),
+			SkipScopeCheck:        d.Get("skip_scope_check").(bool),
 		}
+
+		var err error
+		if config.MinBackoff, err = parseDuration(d.Get("min_backoff").(string)); err != nil {
+			return nil, diag.FromErr(err)
+		}
+		if config.MaxBackoff, err = parseDuration(d.Get("max_backoff").(string)); err != nil {
+			return nil, diag.FromErr(err)
+		}
+		if config.RequestTimeout, err = parseDuration(d.Get("request_timeout").(string)); err != nil {
+			return nil, diag.FromErr(err)
+		}
+		if config.AlertTaskPolling.Interval, err = parseDuration(d.Get("alert_task_poll_interval").(string)); err != nil {
+			return nil, diag.FromErr(err)
+		}
+		if config.AlertTaskPolling.MaxInterval, err = parseDuration(d.Get("alert_task_poll_max_interval").(string)); err != nil {
+			return nil, diag.FromErr(err)
+		}
+		if config.AlertTaskPolling.Timeout, err = parseDuration(d.Get("alert_task_timeout").(string)); err != nil {
+			return nil, diag.FromErr(err)
+		}
+		config.AlertTaskPolling.Backoff = d.Get("alert_task_poll_backoff").(float64)
+		if config.MinBackoff > config.MaxBackoff {
+			return nil, diag.Errorf("min_backoff (%s) must not be greater than max_backoff (%s)", config.MinBackoff, config.MaxBackoff)
+		}
+		if config.AlertTaskPolling.Interval <= 0 {
+			return nil, diag.Errorf("alert_task_poll_interval must be greater than zero")
+		}
+		if config.AlertTaskPolling.Interval > config.AlertTaskPolling.MaxInterval {
+			return nil, diag.Errorf("alert_task_poll_interval (%s) must not be greater than alert_task_poll_max_interval (%s)",
+				config.AlertTaskPolling.Interval, config.AlertTaskPolling.MaxInterval)
+		}
+
 		return config.Client(ctx)
 	}
 }
+
+// parseDuration parses a duration such as "30s". An empty string is a zero duration.
+func parseDuration(v string) (time.Duration, error) {
+	if v == "" {
+		return 0, nil
+	}
+	return time.ParseDuration(v)
+}
+
+func validateDuration(i interface{}, k string) ([]string, []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+	}
+	if d, err := parseDuration(v); err != nil {
+		return nil, []error{fmt.Errorf("expected %s to be a duration such as \"30s\", got %q", k, v)}
+	} else if d < 0 {
+		return nil, []error{fmt.Errorf("expected %s to not be negative, got %q", k, v)}
+	}
+	return nil, nil
+}
@@ -3,6 +3,7 @@ package sentry
 import (
 	"os"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -39,3 +40,61 @@ func testAccPreCheck(t *testing.T) {
 		t.Fatal("SENTRY_TEST_ORGANIZATION must be set for acceptance tests")
 	}
 }
+
+func TestProvider_envDefaults(t *testing.T) {
+	t.Setenv("SENTRY_MAX_RETRIES", "10")
+	t.Setenv("SENTRY_MAX_BACKOFF", "2m")
+	t.Setenv("SENTRY_MAX_CONCURRENT_REQUESTS", "5")
+	t.Setenv("SENTRY_ALERT_TASK_POLL_BACKOFF", "2")
+	t.Setenv("SENTRY_ALERT_TASK_TIMEOUT", "1m")
+
+	d := schema.TestResourceDataRaw(t, NewProvider("dev")().Schema, map[string]interface{}{})
+	if v := d.Get("max_retries").(int); v != 10 {
+		t.Errorf("max_retries: expected 10, got %d", v)
+	}
+	if v := d.Get("min_backoff").(string); v != "1s" {
+		t.Errorf("min_backoff: expected 1s, got %s", v)
+	}
+	if v := d.Get("max_backoff").(string); v != "2m" {
+		t.Errorf("max_backoff: expected 2m, got %s", v)
+	}
+	if v := d.Get("max_concurrent_requests").(int); v != 5 {
+		t.Errorf("max_concurrent_requests: expected 5, got %d", v)
+	}
+	if v := d.Get("alert_task_poll_interval").(string); v != "2s" {
+		t.Errorf("alert_task_poll_interval: expected 2s, got %s", v)
+	}
+	if v := d.Get("alert_task_poll_backoff").(float64); v != 2 {
+		t.Errorf("alert_task_poll_backoff: expected 2, got %v", v)
+	}
+	if v := d.Get("alert_task_timeout").(string); v != "1m" {
+		t.Errorf("alert_task_timeout: expected 1m, got %s", v)
+	}
+}
+
+func TestProvider_timeouts(t *testing.T) {
+	for name, r := range NewProvider("dev")().ResourcesMap {
+		if r.Timeouts == nil {
+			t.Errorf("%s: expected timeouts", name)
+			continue
+		}
+		for op, declared := range map[string]bool{
+			schema.TimeoutCreate: r.CreateContext == nil || r.Timeouts.Create != nil,
+			schema.TimeoutRead:   r.ReadContext == nil || r.Timeouts.Read != nil,
+			schema.TimeoutUpdate: r.UpdateContext == nil || r.Timeouts.Update != nil,
+			schema.TimeoutDelete: r.DeleteContext == nil || r.Timeouts.Delete != nil,
+		} {
+			if !declared {
+				t.Errorf("%s: expected a %s timeout", name, op)
+			}
+		}
+	}
+
+	r := NewProvider("dev")().ResourcesMap["sentry_issue_alert"]
+	if v := *r.Timeouts.Create; v != 5*time.Minute {
+		t.Errorf("sentry_issue_alert: expected a create timeout of 5m, got %s", v)
+	}
+	if v := *r.Timeouts.Delete; v != defaultTimeout {
+		t.Errorf("sentry_issue_alert: expected a delete timeout of %s, got %s", defaultTimeout, v)
+	}
+}
@@ -2,7 +2,6 @@ package sentry
 
 import (
 	"context"
-	"net/http"
 
 	"github.com/getkevin/terraform-provider-sentry/sentry/lib"
 	"github.com/hashicorp/go-multierror"
@@ -264,7 +263,7 @@ func resourceSentryDashboardCreate(ctx context.Context, d *schema.ResourceData,
 	})
 	dashboard, _, err := client.Dashboards.Create(ctx, org, dashboardReq)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err, d)
 	}
 
 	d.SetId(buildTwoPartID(org, sentry.StringValue(dashboard.ID)))
@@ -285,15 +284,13 @@ func resourceSentryDashboardRead(ctx context.Context, d *schema.ResourceData, me
 	})
 	dashboard, _, err := client.Dashboards.Get(ctx, org, dashboardID)
 	if err != nil {
-		if sErr, ok := err.(*sentry.ErrorResponse); ok {
-			if sErr.Response.StatusCode == http.StatusNotFound {
-				tflog.Info(ctx, "Removing dashboard from state because it no longer exists in Sentry", map[string]interface{}{
-					"org":         org,
-					"dashboardID": dashboardID,
-				})
-				d.SetId("")
-				return nil
-			}
+		if isNotFound(err) {
+			tflog.Info(ctx, "Removing dashboard from state because it no longer exists in Sentry", map[string]interface{}{
+				"org":         org,
+				"dashboardID": dashboardID,
+			})
+			d.SetId("")
+			return nil
 		}
 		return diag.FromErr(err)
 	}
@@ -323,7 +320,7 @@ func resourceSentryDashboardUpdate(ctx context.Context, d *schema.ResourceData,
 	})
 	_, _, err = client.Dashboards.Update(ctx, org, dashboardID, dashboardReq)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err, d)
 	}
 	return resourceSentryDashboardRead(ctx, d, meta)
 }
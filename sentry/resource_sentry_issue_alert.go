@@ -2,12 +2,14 @@ package sentry
 
 import (
 	"context"
-	"net/http"
+	"fmt"
+	"sort"
 
 	"github.com/getkevin/terraform-provider-sentry/sentry/lib"
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/mitchellh/mapstructure"
@@ -19,7 +21,9 @@ func resourceSentryIssueAlert() *schema.Resource {
 			"values of conditions, filters, and actions. You can either inspect the request " +
 			"payload sent when creating or editing an issue alert on Sentry or inspect " +
 			"[Sentry's rules registry in the source code](https://github.com/getsentry/sentry/tree/master/src/sentry/rules). " +
-			"Since v0.11.2, you should also omit the name property of each condition, filter, and action.",
+			"Since v0.11.2, you should also omit the name property of each condition, filter, and action. " +
+			"The `sentry_issue_alert_configuration` data source lists the conditions, filters, and actions " +
+			"available to a project, and their IDs are checked against it at plan time.",
 
 		CreateContext: resourceSentryIssueAlertCreate,
 		ReadContext:   resourceSentryIssueAlertRead,
@@ -30,7 +34,13 @@ func resourceSentryIssueAlert() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
-		Schema:        resourceSentryIssueAlertSchema(),
+		Timeouts: alertTimeouts(),
+
+		Schema: resourceSentryIssueAlertSchema(),
+		CustomizeDiff: customdiff.All(
+			validateIssueAlertBlocks,
+			validateIssueAlertIDs,
+		),
 		SchemaVersion: 1,
 		StateUpgraders: []schema.StateUpgrader{
 			{
@@ -61,29 +71,47 @@ func resourceSentryIssueAlertSchema() map[string]*schema.Schema {
 			ValidateFunc: validation.StringLenBetween(1, 64),
 		},
 		"conditions": {
-			Description: "List of conditions.",
+			Description: "List of conditions as raw objects of the Sentry API. Conflicts with `condition`.",
 			Type:        schema.TypeList,
-			Required:    true,
+			Optional:    true,
 			Elem: &schema.Schema{
 				Type: schema.TypeMap,
 			},
+			DiffSuppressFunc: suppressEquivalentIssueAlertObjects,
+			ExactlyOneOf:     []string{"conditions", "condition"},
 		},
+		"condition": withConflicts(
+			issueAlertBlockSchema("List of typed conditions. Conflicts with `conditions`.", issueAlertConditionRegistry),
+			"conditions",
+		),
 		"filters": {
-			Description: "List of filters.",
+			Description: "List of filters as raw objects of the Sentry API. Conflicts with `filter`.",
 			Type:        schema.TypeList,
 			Optional:    true,
 			Elem: &schema.Schema{
 				Type: schema.TypeMap,
 			},
+			DiffSuppressFunc: suppressEquivalentIssueAlertObjects,
+			ConflictsWith:    []string{"filter"},
 		},
+		"filter": withConflicts(
+			issueAlertBlockSchema("List of typed filters. Conflicts with `filters`.", issueAlertFilterRegistry),
+			"filters",
+		),
 		"actions": {
-			Description: "List of actions.",
+			Description: "List of actions as raw objects of the Sentry API. Conflicts with `action`.",
 			Type:        schema.TypeList,
-			Required:    true,
+			Optional:    true,
 			Elem: &schema.Schema{
 				Type: schema.TypeMap,
 			},
+			DiffSuppressFunc: suppressEquivalentIssueAlertObjects,
+			ExactlyOneOf:     []string{"actions", "action"},
 		},
+		"action": withConflicts(
+			issueAlertBlockSchema("List of typed actions. Conflicts with `actions`.", issueAlertActionRegistry),
+			"actions",
+		),
 		"action_match": {
 			Description:  "Trigger actions when an event is captured by Sentry and `any` or `all` of the specified conditions happen.",
 			Type:         schema.TypeString,
@@ -138,7 +166,7 @@ func resourceSentryIssueAlertStateUpgradeV0(ctx context.Context, rawState map[st
 	return rawState, nil
 }
 
-func resourceSentryIssueAlertObject(d *schema.ResourceData) *sentry.IssueAlert {
+func resourceSentryIssueAlertObject(d *schema.ResourceData) (*sentry.IssueAlert, error) {
 	alert := &sentry.IssueAlert{
 		Name:        sentry.String(d.Get("name").(string)),
 		ActionMatch: sentry.String(d.Get("action_match").(string)),
@@ -169,6 +197,41 @@ func resourceSentryIssueAlertObject(d *schema.ResourceData) *sentry.IssueAlert {
 		alert.Actions = append(alert.Actions, action)
 	}
 
+	// Typed blocks keep the types of their values.
+	if v, ok := d.GetOk("condition"); ok {
+		conditions, err := expandIssueAlertBlocks(v.([]interface{}), d.GetRawConfig().GetAttr("condition"), issueAlertConditionRegistry)
+		if err != nil {
+			return nil, fmt.Errorf("condition: %w", err)
+		}
+		alert.Conditions = make([]*sentry.IssueAlertCondition, 0, len(conditions))
+		for _, c := range conditions {
+			condition := sentry.IssueAlertCondition(c)
+			alert.Conditions = append(alert.Conditions, &condition)
+		}
+	}
+	if v, ok := d.GetOk("filter"); ok {
+		filters, err := expandIssueAlertBlocks(v.([]interface{}), d.GetRawConfig().GetAttr("filter"), issueAlertFilterRegistry)
+		if err != nil {
+			return nil, fmt.Errorf("filter: %w", err)
+		}
+		alert.Filters = make([]*sentry.IssueAlertFilter, 0, len(filters))
+		for _, f := range filters {
+			filter := sentry.IssueAlertFilter(f)
+			alert.Filters = append(alert.Filters, &filter)
+		}
+	}
+	if v, ok := d.GetOk("action"); ok {
+		actions, err := expandIssueAlertBlocks(v.([]interface{}), d.GetRawConfig().GetAttr("action"), issueAlertActionRegistry)
+		if err != nil {
+			return nil, fmt.Errorf("action: %w", err)
+		}
+		alert.Actions = make([]*sentry.IssueAlertAction, 0, len(actions))
+		for _, a := range actions {
+			action := sentry.IssueAlertAction(a)
+			alert.Actions = append(alert.Actions, &action)
+		}
+	}
+
 	if v, ok := d.GetOk("environment"); ok {
 		alert.Environment = sentry.String(v.(string))
 	}
@@ -177,7 +240,7 @@ func resourceSentryIssueAlertObject(d *schema.ResourceData) *sentry.IssueAlert {
 		alert.Projects = []string{v.(string)}
 	}
 
-	return alert
+	return alert, nil
 }
 
 func resourceSentryIssueAlertCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -185,7 +248,10 @@ func resourceSentryIssueAlertCreate(ctx context.Context, d *schema.ResourceData,
 
 	org := d.Get("organization").(string)
 	project := d.Get("project").(string)
-	alertReq := resourceSentryIssueAlertObject(d)
+	alertReq, err := resourceSentryIssueAlertObject(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
 	tflog.Debug(ctx, "Creating issue alert", map[string]interface{}{
 		"org":       org,
@@ -194,7 +260,7 @@ func resourceSentryIssueAlertCreate(ctx context.Context, d *schema.ResourceData,
 	})
 	alert, _, err := client.IssueAlerts.Create(ctx, org, project, alertReq)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err, d)
 	}
 
 	d.SetId(buildThreePartID(org, project, sentry.StringValue(alert.ID)))
@@ -212,19 +278,17 @@ func resourceSentryIssueAlertRead(ctx context.Context, d *schema.ResourceData, m
 	tflog.Debug(ctx, "Reading issue alert", map[string]interface{}{"org": org, "project": project, "alertID": alertID})
 	alert, _, err := client.IssueAlerts.Get(ctx, org, project, alertID)
 	if err != nil {
-		if sErr, ok := err.(*sentry.ErrorResponse); ok {
-			if sErr.Response.StatusCode == http.StatusNotFound {
-				tflog.Info(ctx, "Removing issue alert from state because it no longer exists in Sentry", map[string]interface{}{"org": org, "project": project, "alertID": alertID})
-				d.SetId("")
-				return nil
-			}
+		if isNotFound(err) {
+			tflog.Info(ctx, "Removing issue alert from state because it no longer exists in Sentry", map[string]interface{}{"org": org, "project": project, "alertID": alertID})
+			d.SetId("")
+			return nil
 		}
 		return diag.FromErr(err)
 	}
 
-	conditions := followShape(d.Get("conditions"), normalizeSentryIssueAlertProperty(alert.Conditions))
-	filters := followShape(d.Get("filters"), normalizeSentryIssueAlertProperty(alert.Filters))
-	actions := followShape(d.Get("actions"), normalizeSentryIssueAlertProperty(alert.Actions))
+	conditions := shapeIssueAlertObjects(d.Get("conditions").([]interface{}), normalizeSentryIssueAlertProperty(alert.Conditions))
+	filters := shapeIssueAlertObjects(d.Get("filters").([]interface{}), normalizeSentryIssueAlertProperty(alert.Filters))
+	actions := shapeIssueAlertObjects(d.Get("actions").([]interface{}), normalizeSentryIssueAlertProperty(alert.Actions))
 
 	d.SetId(buildThreePartID(org, project, sentry.StringValue(alert.ID)))
 	retErr := multierror.Append(
@@ -240,6 +304,19 @@ func resourceSentryIssueAlertRead(ctx context.Context, d *schema.ResourceData, m
 		d.Set("environment", alert.Environment),
 		d.Set("internal_id", alert.ID),
 	)
+	// Objects which the typed blocks cannot represent are kept as raw objects.
+	if _, ok := d.GetOk("condition"); ok {
+		blocks, unknown := flattenIssueAlertBlocks(normalizeSentryIssueAlertProperty(alert.Conditions), issueAlertConditionRegistry)
+		retErr = multierror.Append(retErr, d.Set("condition", blocks), d.Set("conditions", shapeIssueAlertObjects(nil, unknown)))
+	}
+	if _, ok := d.GetOk("filter"); ok {
+		blocks, unknown := flattenIssueAlertBlocks(normalizeSentryIssueAlertProperty(alert.Filters), issueAlertFilterRegistry)
+		retErr = multierror.Append(retErr, d.Set("filter", blocks), d.Set("filters", shapeIssueAlertObjects(nil, unknown)))
+	}
+	if _, ok := d.GetOk("action"); ok {
+		blocks, unknown := flattenIssueAlertBlocks(normalizeSentryIssueAlertProperty(alert.Actions), issueAlertActionRegistry)
+		retErr = multierror.Append(retErr, d.Set("action", blocks), d.Set("actions", shapeIssueAlertObjects(nil, unknown)))
+	}
 	if len(alert.Projects) == 1 {
 		retErr = multierror.Append(
 			retErr,
@@ -256,7 +333,10 @@ func resourceSentryIssueAlertUpdate(ctx context.Context, d *schema.ResourceData,
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	alertReq := resourceSentryIssueAlertObject(d)
+	alertReq, err := resourceSentryIssueAlertObject(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
 	tflog.Debug(ctx, "Updating issue alert", map[string]interface{}{
 		"org":     org,
@@ -265,7 +345,7 @@ func resourceSentryIssueAlertUpdate(ctx context.Context, d *schema.ResourceData,
 	})
 	_, _, err = client.IssueAlerts.Update(ctx, org, project, alertID, alertReq)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err, d)
 	}
 	return resourceSentryIssueAlertRead(ctx, d, meta)
 }
@@ -287,6 +367,155 @@ func resourceSentryIssueAlertDelete(ctx context.Context, d *schema.ResourceData,
 	return diag.FromErr(err)
 }
 
+// validateIssueAlertIDs checks at plan time that the conditions, filters and
+// actions are available to the project, or are hidden entries which Sentry
+// still accepts. The check is skipped if the configuration of the project
+// can't be retrieved, e.g. because the project doesn't exist yet.
+func validateIssueAlertIDs(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	client, ok := meta.(*sentry.Client)
+	if !ok {
+		return nil
+	}
+	if !d.HasChanges("organization", "project", "conditions", "condition", "filters", "filter", "actions", "action") {
+		return nil
+	}
+	if !d.NewValueKnown("organization") || !d.NewValueKnown("project") {
+		return nil
+	}
+	org := d.Get("organization").(string)
+	project := d.Get("project").(string)
+	if org == "" || project == "" {
+		return nil
+	}
+
+	type check struct {
+		kind    string
+		ids     map[string]string
+		entries func(*sentry.IssueAlertConfiguration) []*sentry.IssueAlertConfigurationEntry
+	}
+	checks := []check{
+		{
+			kind: "condition",
+			ids:  issueAlertConfiguredIDs(d, "conditions", "condition", issueAlertConditionRegistry),
+			entries: func(c *sentry.IssueAlertConfiguration) []*sentry.IssueAlertConfigurationEntry {
+				return c.Conditions
+			},
+		},
+		{
+			kind: "filter",
+			ids:  issueAlertConfiguredIDs(d, "filters", "filter", issueAlertFilterRegistry),
+			entries: func(c *sentry.IssueAlertConfiguration) []*sentry.IssueAlertConfigurationEntry {
+				return c.Filters
+			},
+		},
+		{
+			kind: "action",
+			ids:  issueAlertConfiguredIDs(d, "actions", "action", issueAlertActionRegistry),
+			entries: func(c *sentry.IssueAlertConfiguration) []*sentry.IssueAlertConfigurationEntry {
+				return c.Actions
+			},
+		},
+	}
+	if len(checks[0].ids)+len(checks[1].ids)+len(checks[2].ids) == 0 {
+		return nil
+	}
+
+	configuration, _, err := client.IssueAlerts.GetConfiguration(ctx, org, project)
+	if err != nil {
+		tflog.Warn(ctx, "Skipping the validation of issue alert IDs because the configuration of the project could not be retrieved", map[string]interface{}{
+			"org":     org,
+			"project": project,
+			"error":   err.Error(),
+		})
+		return nil
+	}
+
+	var retErr *multierror.Error
+	for _, c := range checks {
+		available := issueAlertAvailableIDs(c.kind, c.entries(configuration))
+
+		addresses := make([]string, 0, len(c.ids))
+		for address := range c.ids {
+			addresses = append(addresses, address)
+		}
+		sort.Strings(addresses)
+		for _, address := range addresses {
+			if id := c.ids[address]; !available[id] {
+				retErr = multierror.Append(retErr, fmt.Errorf(
+					"%s: %q is not an available %s of project %q, see the sentry_issue_alert_configuration data source for the available %ss",
+					address, id, c.kind, project, c.kind,
+				))
+			}
+		}
+	}
+	return retErr.ErrorOrNil()
+}
+
+// issueAlertHiddenIDs are the conditions, filters and actions which Sentry
+// accepts although the configuration of a project doesn't list them, e.g.
+// legacy conditions which were migrated to filters, or entries behind feature
+// flags.
+var issueAlertHiddenIDs = map[string][]string{
+	"condition": {
+		"sentry.rules.conditions.every_event.EveryEventCondition",
+		"sentry.rules.conditions.event_attribute.EventAttributeCondition",
+		"sentry.rules.conditions.level.LevelCondition",
+		"sentry.rules.conditions.tagged_event.TaggedEventCondition",
+		"sentry.rules.conditions.high_priority_issue.HighPriorityIssueCondition",
+		"sentry.rules.conditions.new_high_priority_issue.NewHighPriorityIssueCondition",
+		"sentry.rules.conditions.existing_high_priority_issue.ExistingHighPriorityIssueCondition",
+	},
+	"filter": {
+		"sentry.rules.filters.issue_category.IssueCategoryFilter",
+		"sentry.rules.filters.issue_severity.IssueSeverityFilter",
+	},
+	"action": {
+		"sentry.rules.actions.notify_event_sentry_app.NotifyEventSentryAppAction",
+	},
+}
+
+// issueAlertAvailableIDs returns the IDs which pass the plan time check: the
+// entries of the configuration of the project and the hidden entries.
+func issueAlertAvailableIDs(kind string, entries []*sentry.IssueAlertConfigurationEntry) map[string]bool {
+	available := make(map[string]bool)
+	for _, entry := range entries {
+		available[entry.ID] = true
+	}
+	for _, id := range issueAlertHiddenIDs[kind] {
+		available[id] = true
+	}
+	return available
+}
+
+// issueAlertConfiguredIDs returns the known IDs of the raw objects or typed
+// blocks, keyed by their address.
+func issueAlertConfiguredIDs(d *schema.ResourceDiff, attribute, block string, registry []issueAlertRegistryEntry) map[string]string {
+	ids := make(map[string]string)
+
+	config := d.GetRawConfig()
+	if !config.IsNull() && !config.GetAttr(attribute).IsNull() && d.NewValueKnown(attribute) {
+		for i := range d.Get(attribute).([]interface{}) {
+			address := fmt.Sprintf("%s.%d.id", attribute, i)
+			if !d.NewValueKnown(address) {
+				continue
+			}
+			if id, _ := d.Get(address).(string); id != "" {
+				ids[address] = id
+			}
+		}
+	}
+
+	for i, v := range d.Get(block).([]interface{}) {
+		m, _ := v.(map[string]interface{})
+		for _, entry := range registry {
+			if l, ok := m[entry.Block].([]interface{}); ok && len(l) > 0 {
+				ids[fmt.Sprintf("%s.%d.%s", block, i, entry.Block)] = entry.ID
+			}
+		}
+	}
+	return ids
+}
+
 func normalizeSentryIssueAlertProperty[T interface{ ~map[string]interface{} }](v []*T) []interface{} {
 	out := make([]interface{}, 0, len(v))
 	for _, c := range v {
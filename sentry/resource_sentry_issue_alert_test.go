@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/getkevin/terraform-provider-sentry/sentry/lib"
@@ -149,6 +150,216 @@ func TestAccSentryIssueAlert_basic(t *testing.T) {
 	})
 }
 
+func TestAccSentryIssueAlert_typedBlocks(t *testing.T) {
+	teamName := acctest.RandomWithPrefix("tf-team")
+	projectName := acctest.RandomWithPrefix("tf-project")
+	alertName := acctest.RandomWithPrefix("tf-issue-alert")
+	rn := "sentry_issue_alert.test"
+
+	var alertID string
+
+	check := func(value string) resource.TestCheckFunc {
+		return resource.ComposeTestCheckFunc(
+			testAccCheckSentryIssueAlertExists(rn, &alertID),
+			resource.TestCheckResourceAttr(rn, "conditions.#", "0"),
+			resource.TestCheckResourceAttr(rn, "condition.#", "2"),
+			resource.TestCheckResourceAttr(rn, "condition.0.first_seen_event.#", "1"),
+			resource.TestCheckResourceAttr(rn, "condition.1.event_frequency.0.comparison_type", "count"),
+			resource.TestCheckResourceAttr(rn, "condition.1.event_frequency.0.value", value),
+			resource.TestCheckResourceAttr(rn, "condition.1.event_frequency.0.interval", "1h"),
+			resource.TestCheckResourceAttr(rn, "filter.#", "2"),
+			resource.TestCheckResourceAttr(rn, "filter.0.tagged_event.0.key", "environment"),
+			resource.TestCheckResourceAttr(rn, "filter.0.tagged_event.0.match", "eq"),
+			resource.TestCheckResourceAttr(rn, "filter.0.tagged_event.0.value", "production"),
+			resource.TestCheckResourceAttr(rn, "filter.1.level.0.match", "gte"),
+			resource.TestCheckResourceAttr(rn, "filter.1.level.0.level", "40"),
+			resource.TestCheckResourceAttr(rn, "action.#", "2"),
+			resource.TestCheckResourceAttr(rn, "action.0.mail_action.0.target_type", "IssueOwners"),
+			resource.TestCheckResourceAttr(rn, "action.0.mail_action.0.fallthrough_type", "ActiveMembers"),
+			resource.TestCheckResourceAttr(rn, "action.1.notify_event_service.0.service", "mail"),
+		)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckSentryIssueAlertDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSentryIssueAlertConfig_typedBlocks(teamName, projectName, alertName, 100),
+				Check:  check("100"),
+			},
+			{
+				Config: testAccSentryIssueAlertConfig_typedBlocks(teamName, projectName, alertName, 200),
+				Check:  check("200"),
+			},
+		},
+	})
+}
+
+func TestAccSentryIssueAlert_typedBlocksConflict(t *testing.T) {
+	teamName := acctest.RandomWithPrefix("tf-team")
+	projectName := acctest.RandomWithPrefix("tf-project")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSentryProjectConfig_team(teamName, projectName) + `
+resource "sentry_issue_alert" "test" {
+	organization = sentry_project.test.organization
+	project      = sentry_project.test.id
+	name         = "conflict"
+
+	action_match = "any"
+	filter_match = "any"
+	frequency    = 30
+
+	conditions = [
+		{
+			id = "sentry.rules.conditions.first_seen_event.FirstSeenEventCondition"
+		},
+	]
+	condition {
+		first_seen_event {}
+	}
+
+	action {
+		notify_event {}
+	}
+}
+				`,
+				ExpectError: regexp.MustCompile(`"condition": conflicts with conditions`),
+			},
+			{
+				Config: testAccSentryProjectConfig_team(teamName, projectName) + `
+resource "sentry_issue_alert" "test" {
+	organization = sentry_project.test.organization
+	project      = sentry_project.test.id
+	name         = "multiple kinds"
+
+	action_match = "any"
+	filter_match = "any"
+	frequency    = 30
+
+	condition {
+		first_seen_event {}
+		regression_event {}
+	}
+
+	action {
+		notify_event {}
+	}
+}
+				`,
+				ExpectError: regexp.MustCompile(`condition.0: exactly one of`),
+			},
+		},
+	})
+}
+
+func TestAccSentryIssueAlert_equivalentObjects(t *testing.T) {
+	teamName := acctest.RandomWithPrefix("tf-team")
+	projectName := acctest.RandomWithPrefix("tf-project")
+	alertName := acctest.RandomWithPrefix("tf-issue-alert")
+	rn := "sentry_issue_alert.test"
+
+	var alertID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckSentryIssueAlertDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSentryIssueAlertConfig_objects(teamName, projectName, alertName, `
+	conditions = [
+		{
+			id = "sentry.rules.conditions.first_seen_event.FirstSeenEventCondition"
+		},
+		{
+			id             = "sentry.rules.conditions.event_frequency.EventFrequencyCondition"
+			comparisonType = "count"
+			value          = 100
+			interval       = "1h"
+		},
+	]
+
+	actions = [
+		{
+			id         = "sentry.mail.actions.NotifyEmailAction"
+			targetType = "IssueOwners"
+		},
+	]
+				`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSentryIssueAlertExists(rn, &alertID),
+					resource.TestCheckResourceAttr(rn, "conditions.#", "2"),
+					resource.TestCheckResourceAttr(rn, "actions.#", "1"),
+				),
+			},
+			{
+				// Reordered, with different value types and defaults.
+				Config: testAccSentryIssueAlertConfig_objects(teamName, projectName, alertName, `
+	conditions = [
+		{
+			id       = "sentry.rules.conditions.event_frequency.EventFrequencyCondition"
+			value    = "100.0"
+			interval = "1h"
+		},
+		{
+			id = "sentry.rules.conditions.first_seen_event.FirstSeenEventCondition"
+		},
+	]
+
+	actions = [
+		{
+			id              = "sentry.mail.actions.NotifyEmailAction"
+			targetType      = "IssueOwners"
+			fallthroughType = "ActiveMembers"
+		},
+	]
+				`),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccSentryIssueAlert_unavailableID(t *testing.T) {
+	teamName := acctest.RandomWithPrefix("tf-team")
+	projectName := acctest.RandomWithPrefix("tf-project")
+	alertName := acctest.RandomWithPrefix("tf-issue-alert")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// The IDs can only be checked once the project exists.
+				Config: testAccSentryProjectConfig_team(teamName, projectName),
+			},
+			{
+				Config: testAccSentryIssueAlertConfig_objects(teamName, projectName, alertName, `
+	conditions = [
+		{
+			id = "sentry.rules.conditions.unknown.UnknownCondition"
+		},
+	]
+
+	actions = [
+		{
+			id = "sentry.rules.actions.notify_event.NotifyEventAction"
+		},
+	]
+				`),
+				ExpectError: regexp.MustCompile(`conditions\.0\.id: "sentry\.rules\.conditions\.unknown\.UnknownCondition" is not an available condition`),
+			},
+		},
+	})
+}
+
 func testAccCheckSentryIssueAlertDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*sentry.Client)
 
@@ -298,3 +509,87 @@ resource "sentry_issue_alert" "test" {
 }
 	`, alertName)
 }
+
+func testAccSentryIssueAlertConfig_typedBlocks(teamName, projectName, alertName string, value int) string {
+	return testAccSentryProjectConfig_team(teamName, projectName) + fmt.Sprintf(`
+resource "sentry_issue_alert" "test" {
+	organization = sentry_project.test.organization
+	project      = sentry_project.test.id
+	name         = "%[1]s"
+
+	action_match = "any"
+	filter_match = "all"
+	frequency    = 30
+
+	condition {
+		first_seen_event {}
+	}
+	condition {
+		event_frequency {
+			comparison_type = "count"
+			value           = %[2]d
+			interval        = "1h"
+		}
+	}
+
+	filter {
+		tagged_event {
+			key   = "environment"
+			match = "eq"
+			value = "production"
+		}
+	}
+	filter {
+		level {
+			match = "gte"
+			level = "40"
+		}
+	}
+
+	action {
+		mail_action {
+			target_type      = "IssueOwners"
+			fallthrough_type = "ActiveMembers"
+		}
+	}
+	action {
+		notify_event_service {
+			service = "mail"
+		}
+	}
+}
+	`, alertName, value)
+}
+
+func testAccSentryIssueAlertConfig_objects(teamName, projectName, alertName, objects string) string {
+	return testAccSentryProjectConfig_team(teamName, projectName) + fmt.Sprintf(`
+resource "sentry_issue_alert" "test" {
+	organization = sentry_project.test.organization
+	project      = sentry_project.test.id
+	name         = "%[1]s"
+
+	action_match = "any"
+	filter_match = "any"
+	frequency    = 30
+%[2]s
+}
+	`, alertName, objects)
+}
+
+func TestIssueAlertAvailableIDs(t *testing.T) {
+	available := issueAlertAvailableIDs("condition", []*sentry.IssueAlertConfigurationEntry{
+		{ID: "sentry.rules.conditions.first_seen_event.FirstSeenEventCondition"},
+	})
+
+	for id, want := range map[string]bool{
+		"sentry.rules.conditions.first_seen_event.FirstSeenEventCondition": true,
+		"sentry.rules.conditions.tagged_event.TaggedEventCondition":        true,
+		"sentry.rules.conditions.level.LevelCondition":                     true,
+		"sentry.rules.conditions.unknown.UnknownCondition":                 false,
+		"sentry.rules.filters.issue_category.IssueCategoryFilter":          false,
+	} {
+		if got := available[id]; got != want {
+			t.Errorf("issueAlertAvailableIDs()[%q] = %v, want %v", id, got, want)
+		}
+	}
+}
@@ -95,8 +95,8 @@ func resourceSentryKeyCreate(ctx context.Context, d *schema.ResourceData, meta i
 	org := d.Get("organization").(string)
 	project := d.Get("project").(string)
 
-	_, resp, err := client.Projects.Get(ctx, org, project)
-	if found, err := checkClientGet(resp, err, d); !found {
+	_, _, err := client.Projects.Get(ctx, org, project)
+	if found, err := checkClientGet(err, d); !found {
 		return diag.Errorf("project not found \"%v\": %v", project, err)
 	}
 
@@ -115,7 +115,7 @@ func resourceSentryKeyCreate(ctx context.Context, d *schema.ResourceData, meta i
 	})
 	key, _, err := client.ProjectKeys.Create(ctx, org, project, params)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err, d)
 	}
 	tflog.Debug(ctx, "Created Sentry key", map[string]interface{}{
 		"keyID":   key.ID,
@@ -141,18 +141,11 @@ func resourceSentryKeyRead(ctx context.Context, d *schema.ResourceData, meta int
 		"project": project,
 	})
 
-	listParams := &sentry.ListCursorParams{}
-	var allKeys []*sentry.ProjectKey
-	for {
-		keys, resp, err := client.ProjectKeys.List(ctx, org, project, listParams)
-		if found, err := checkClientGet(resp, err, d); !found {
-			return diag.FromErr(err)
-		}
-		allKeys = append(allKeys, keys...)
-		if resp.Cursor == "" {
-			break
-		}
-		listParams.Cursor = resp.Cursor
+	allKeys, err := sentry.ListAll(ctx, nil, func(ctx context.Context, cursor sentry.ListCursorParams) ([]*sentry.ProjectKey, *sentry.Response, error) {
+		return client.ProjectKeys.List(ctx, org, project, &cursor)
+	})
+	if found, err := checkClientGet(err, d); !found {
+		return diag.FromErr(err)
 	}
 	tflog.Trace(ctx, "Read Sentry keys", map[string]interface{}{
 		"keyCount": len(allKeys),
@@ -225,7 +218,7 @@ func resourceSentryKeyUpdate(ctx context.Context, d *schema.ResourceData, meta i
 	})
 	key, _, err := client.ProjectKeys.Update(ctx, org, project, id, params)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err, d)
 	}
 	tflog.Debug(ctx, "Updated Sentry key", map[string]interface{}{
 		"keyID": id,
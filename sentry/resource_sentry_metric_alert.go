@@ -3,7 +3,6 @@ package sentry
 import (
 	"context"
 	"fmt"
-	"net/http"
 
 	"github.com/getkevin/terraform-provider-sentry/sentry/lib"
 	"github.com/hashicorp/go-multierror"
@@ -25,6 +24,8 @@ func resourceSentryMetricAlert() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: alertTimeouts(),
+
 		Schema: map[string]*schema.Schema{
 			"organization": {
 				Description: "The slug of the organization the metric alert belongs to.",
@@ -212,7 +213,7 @@ func resourceSentryMetricAlertCreate(ctx context.Context, d *schema.ResourceData
 	})
 	alert, _, err := client.MetricAlerts.Create(ctx, org, project, alertReq)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err, d)
 	}
 
 	d.SetId(buildThreePartID(org, project, sentry.StringValue(alert.ID)))
@@ -234,12 +235,10 @@ func resourceSentryMetricAlertRead(ctx context.Context, d *schema.ResourceData,
 	})
 	alert, _, err := client.MetricAlerts.Get(ctx, org, project, alertID)
 	if err != nil {
-		if sErr, ok := err.(*sentry.ErrorResponse); ok {
-			if sErr.Response.StatusCode == http.StatusNotFound {
-				tflog.Info(ctx, "Removing metric alert from state because it no longer exists in Sentry", map[string]interface{}{"org": org})
-				d.SetId("")
-				return nil
-			}
+		if isNotFound(err) {
+			tflog.Info(ctx, "Removing metric alert from state because it no longer exists in Sentry", map[string]interface{}{"org": org})
+			d.SetId("")
+			return nil
 		}
 		return diag.FromErr(err)
 	}
@@ -288,7 +287,7 @@ func resourceSentryMetricAlertUpdate(ctx context.Context, d *schema.ResourceData
 	})
 	alert, _, err := client.MetricAlerts.Update(ctx, org, project, alertID, alertReq)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err, d)
 	}
 
 	d.SetId(buildThreePartID(org, project, sentry.StringValue(alert.ID)))
@@ -0,0 +1,219 @@
+package sentry
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/getkevin/terraform-provider-sentry/sentry/lib"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceSentryNotificationAction() *schema.Resource {
+	return &schema.Resource{
+		Description: "Create a Spike Protection Notification Action. See the " +
+			"[Sentry Documentation](https://docs.sentry.io/api/alerts/create-a-spike-protection-notification-action/) " +
+			"for more information.",
+
+		CreateContext: resourceSentryNotificationActionCreate,
+		ReadContext:   resourceSentryNotificationActionRead,
+		UpdateContext: resourceSentryNotificationActionUpdate,
+		DeleteContext: resourceSentryNotificationActionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: importOrganizationAndID,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"organization": {
+				Description: "The slug of the organization the project belongs to.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"trigger_type": {
+				Description:  "The type of trigger that will activate this action. Valid values are `spike-protection` and `audit-log`.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"spike-protection", "audit-log"}, false),
+			},
+			"service_type": {
+				Description: "The service that is used for sending the notification. " +
+					"Valid values are `email`, `slack`, `pagerduty`, `opsgenie` and `sentry_notification`.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"email", "slack", "pagerduty", "opsgenie", "sentry_notification"}, false),
+			},
+			"integration_id": {
+				Description: "The ID of the integration that is used for sending the notification. " +
+					"Use the `sentry_organization_integration` data source to retrieve an integration. " +
+					"Required if `service_type` is `slack`, `pagerduty` or `opsgenie`.",
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"target_identifier": {
+				Description: "The identifier of the target that is used for sending the notification (e.g. Slack channel ID). " +
+					"Required if `service_type` is `slack` or `opsgenie`.",
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"target_display": {
+				Description: "The display name of the target that is used for sending the notification (e.g. Slack channel name). " +
+					"Required if `service_type` is `slack` or `opsgenie`.",
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"projects": {
+				Description: "The set of project slugs that the Notification Action is created for.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func resourceSentryNotificationActionParams(d *schema.ResourceData) *sentry.CreateNotificationActionParams {
+	params := &sentry.CreateNotificationActionParams{
+		TriggerType: sentry.String(d.Get("trigger_type").(string)),
+		ServiceType: sentry.String(d.Get("service_type").(string)),
+		Projects:    expandStringList(d.Get("projects").(*schema.Set).List()),
+	}
+	if v, ok := d.GetOk("integration_id"); ok {
+		params.IntegrationID = sentry.JSONNumber(json.Number(v.(string)))
+	}
+	if v, ok := d.GetOk("target_identifier"); ok {
+		params.TargetIdentifier = v.(string)
+	}
+	if v, ok := d.GetOk("target_display"); ok {
+		params.TargetDisplay = sentry.String(v.(string))
+	}
+	return params
+}
+
+func resourceSentryNotificationActionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*sentry.Client)
+
+	org := d.Get("organization").(string)
+	params := resourceSentryNotificationActionParams(d)
+
+	tflog.Debug(ctx, "Creating notification action", map[string]interface{}{
+		"org":         org,
+		"triggerType": *params.TriggerType,
+		"serviceType": *params.ServiceType,
+	})
+	action, _, err := client.NotificationActions.Create(ctx, org, params)
+	if err != nil {
+		return diagFromErr(err, d)
+	}
+
+	d.SetId(action.ID.String())
+	return resourceSentryNotificationActionRead(ctx, d, meta)
+}
+
+func resourceSentryNotificationActionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*sentry.Client)
+
+	org := d.Get("organization").(string)
+	actionID := d.Id()
+
+	tflog.Debug(ctx, "Reading notification action", map[string]interface{}{
+		"org":      org,
+		"actionID": actionID,
+	})
+	action, _, err := client.NotificationActions.Get(ctx, org, actionID)
+	if found, err := checkClientGet(err, d); !found {
+		tflog.Info(ctx, "Removed notification action from state because it no longer exists in Sentry", map[string]interface{}{
+			"org":      org,
+			"actionID": actionID,
+		})
+		return diag.FromErr(err)
+	}
+
+	// The API refers to projects by ID, but the resource uses slugs.
+	projects := make([]string, 0, len(action.Projects))
+	if len(action.Projects) > 0 {
+		orgProjects, err := sentry.ListAll(ctx, nil, func(ctx context.Context, cursor sentry.ListCursorParams) ([]*sentry.Project, *sentry.Response, error) {
+			return client.Projects.List(ctx, org, &sentry.ListProjectsParams{ListCursorParams: cursor})
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		slugs := make(map[string]string, len(orgProjects))
+		for _, project := range orgProjects {
+			slugs[project.ID] = project.Slug
+		}
+		for _, projectID := range action.Projects {
+			slug, ok := slugs[projectID.String()]
+			if !ok {
+				tflog.Warn(ctx, "Ignoring unknown project of notification action", map[string]interface{}{
+					"org":       org,
+					"actionID":  actionID,
+					"projectID": projectID.String(),
+				})
+				continue
+			}
+			projects = append(projects, slug)
+		}
+	}
+
+	var integrationID string
+	if action.IntegrationID != nil {
+		integrationID = action.IntegrationID.String()
+	}
+	var targetIdentifier string
+	if action.TargetIdentifier != nil {
+		targetIdentifier = fmt.Sprint(action.TargetIdentifier)
+	}
+
+	retErr := multierror.Append(
+		d.Set("organization", org),
+		d.Set("trigger_type", action.TriggerType),
+		d.Set("service_type", action.ServiceType),
+		d.Set("integration_id", integrationID),
+		d.Set("target_identifier", targetIdentifier),
+		d.Set("target_display", action.TargetDisplay),
+		d.Set("projects", flattenStringSet(projects)),
+	)
+	return diag.FromErr(retErr.ErrorOrNil())
+}
+
+func resourceSentryNotificationActionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*sentry.Client)
+
+	org := d.Get("organization").(string)
+	actionID := d.Id()
+	params := sentry.UpdateNotificationActionParams(*resourceSentryNotificationActionParams(d))
+
+	tflog.Debug(ctx, "Updating notification action", map[string]interface{}{
+		"org":      org,
+		"actionID": actionID,
+	})
+	_, _, err := client.NotificationActions.Update(ctx, org, actionID, &params)
+	if err != nil {
+		return diagFromErr(err, d)
+	}
+
+	return resourceSentryNotificationActionRead(ctx, d, meta)
+}
+
+func resourceSentryNotificationActionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*sentry.Client)
+
+	org := d.Get("organization").(string)
+	actionID := d.Id()
+
+	tflog.Debug(ctx, "Deleting notification action", map[string]interface{}{
+		"org":      org,
+		"actionID": actionID,
+	})
+	_, err := client.NotificationActions.Delete(ctx, org, actionID)
+	return diag.FromErr(err)
+}
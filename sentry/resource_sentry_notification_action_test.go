@@ -0,0 +1,116 @@
+package sentry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/getkevin/terraform-provider-sentry/sentry/lib"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccSentryNotificationAction_basic(t *testing.T) {
+	teamName := acctest.RandomWithPrefix("tf-team")
+	projectName := acctest.RandomWithPrefix("tf-project")
+	rn := "sentry_notification_action.test"
+
+	check := func(targetDisplay string) resource.TestCheckFunc {
+		return resource.ComposeTestCheckFunc(
+			testAccCheckSentryNotificationActionExists(rn),
+			resource.TestCheckResourceAttrPair(rn, "organization", "sentry_project.test", "organization"),
+			resource.TestCheckResourceAttr(rn, "trigger_type", "spike-protection"),
+			resource.TestCheckResourceAttr(rn, "service_type", "sentry_notification"),
+			resource.TestCheckResourceAttr(rn, "target_identifier", "default"),
+			resource.TestCheckResourceAttr(rn, "target_display", targetDisplay),
+			resource.TestCheckResourceAttr(rn, "projects.#", "1"),
+			resource.TestCheckTypeSetElemAttrPair(rn, "projects.*", "sentry_project.test", "id"),
+		)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckSentryNotificationActionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSentryNotificationActionConfig(teamName, projectName, "default"),
+				Check:  check("default"),
+			},
+			{
+				Config: testAccSentryNotificationActionConfig(teamName, projectName, "Sentry"),
+				Check:  check("Sentry"),
+			},
+			{
+				ResourceName:      rn,
+				ImportState:       true,
+				ImportStateIdFunc: testAccSentryNotificationActionImportStateIdFunc(rn),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckSentryNotificationActionDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*sentry.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "sentry_notification_action" {
+			continue
+		}
+
+		ctx := context.Background()
+		_, resp, err := client.NotificationActions.Get(ctx, rs.Primary.Attributes["organization"], rs.Primary.ID)
+		if err == nil {
+			return errors.New("notification action still exists")
+		}
+		if resp.StatusCode != 404 {
+			return err
+		}
+		return nil
+	}
+	return nil
+}
+
+func testAccCheckSentryNotificationActionExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("no ID is set")
+		}
+
+		client := testAccProvider.Meta().(*sentry.Client)
+		ctx := context.Background()
+		_, _, err := client.NotificationActions.Get(ctx, rs.Primary.Attributes["organization"], rs.Primary.ID)
+		return err
+	}
+}
+
+func testAccSentryNotificationActionImportStateIdFunc(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", n)
+		}
+		return buildTwoPartID(rs.Primary.Attributes["organization"], rs.Primary.ID), nil
+	}
+}
+
+func testAccSentryNotificationActionConfig(teamName, projectName, targetDisplay string) string {
+	return testAccSentryProjectConfig_team(teamName, projectName) + fmt.Sprintf(`
+resource "sentry_notification_action" "test" {
+	organization      = sentry_project.test.organization
+	trigger_type      = "spike-protection"
+	service_type      = "sentry_notification"
+	target_identifier = "default"
+	target_display    = %[1]q
+	projects          = [sentry_project.test.id]
+}
+	`, targetDisplay)
+}
@@ -2,13 +2,14 @@ package sentry
 
 import (
 	"context"
-	"net/http"
+	"strings"
 
 	"github.com/getkevin/terraform-provider-sentry/sentry/lib"
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceSentryOrganization() *schema.Resource {
@@ -23,7 +24,7 @@ func resourceSentryOrganization() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
-		Schema: map[string]*schema.Schema{
+		Schema: mergeSchemas(map[string]*schema.Schema{
 			"name": {
 				Description: "The human readable name for the organization.",
 				Type:        schema.TypeString,
@@ -45,10 +46,249 @@ func resourceSentryOrganization() *schema.Resource {
 				Type:        schema.TypeString,
 				Computed:    true,
 			},
+		}, organizationSettingsSchema()),
+	}
+}
+
+// organizationSettingsSchema returns the schema of the security and privacy
+// settings of an organization. Settings which are not configured keep the
+// value they have in Sentry.
+func organizationSettingsSchema() map[string]*schema.Schema {
+	roles := []string{"member", "admin", "manager", "owner"}
+
+	return map[string]*schema.Schema{
+		"is_early_adopter": {
+			Description: "Opt the organization into new features before they are released to the public.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+		},
+		"require_2fa": {
+			Description: "Require members to enable two-factor authentication to access the organization.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+		},
+		"require_email_verification": {
+			Description: "Require members to verify their email address to access the organization.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+		},
+		"default_role": {
+			Description:  "The role new members are given. One of `" + strings.Join(roles, "`, `") + "`.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringInSlice(roles, false),
+		},
+		"open_membership": {
+			Description: "Allow members to freely join or leave any team.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+		},
+		"allow_join_requests": {
+			Description: "Allow users to request to join the organization.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+		},
+		"events_member_admin": {
+			Description: "Allow members to delete events by granting them the `event:admin` scope.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+		},
+		"alerts_member_write": {
+			Description: "Allow members to create, edit and delete alert rules by granting them the `alerts:write` scope.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+		},
+		"attachments_role": {
+			Description:  "The minimum role required to download event attachments. One of `" + strings.Join(roles, "`, `") + "`.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringInSlice(roles, false),
+		},
+		"debug_files_role": {
+			Description:  "The minimum role required to download debug information files, ProGuard mappings and source maps. One of `" + strings.Join(roles, "`, `") + "`.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringInSlice(roles, false),
+		},
+		"allow_shared_issues": {
+			Description: "Allow members to share issues with people outside the organization.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+		},
+		"enhanced_privacy": {
+			Description: "Hide source code and other sensitive data in notifications and the UI.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+		},
+		"data_scrubber": {
+			Description: "Require server-side data scrubbing for all projects.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+		},
+		"data_scrubber_defaults": {
+			Description: "Require the default data scrubbers, e.g. for credit card numbers and passwords, for all projects.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+		},
+		"sensitive_fields": {
+			Description: "Additional field names to scrub in all projects.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			Computed:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"safe_fields": {
+			Description: "Field names which data scrubbers ignore in all projects.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			Computed:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"scrub_ip_addresses": {
+			Description: "Prevent IP addresses from being stored for new events in all projects.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+		},
+		"scrape_javascript": {
+			Description: "Allow Sentry to scrape missing JavaScript source context when possible.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+		},
+		"store_crash_reports": {
+			Description: "The number of native crash reports, such as minidumps, stored per issue. `0` disables storing " +
+				"crash reports and `-1` stores all of them.",
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.IntInSlice([]int{0, 1, 5, 10, 20, 50, 100, -1}),
+		},
+		"relay_pii_config": {
+			Description:      "Advanced data scrubbing rules in the JSON format of Relay's PII configuration.",
+			Type:             schema.TypeString,
+			Optional:         true,
+			Computed:         true,
+			ValidateFunc:     validation.StringIsJSON,
+			DiffSuppressFunc: SuppressEquivalentJSONDiffs,
 		},
 	}
 }
 
+// expandOrganizationSettings sets the configured organization settings on
+// params.
+func expandOrganizationSettings(d *schema.ResourceData, params *sentry.UpdateOrganizationParams) {
+	config := d.GetRawConfig()
+	bools := map[string]**bool{
+		"is_early_adopter":           &params.IsEarlyAdopter,
+		"require_2fa":                &params.Require2FA,
+		"require_email_verification": &params.RequireEmailVerification,
+		"open_membership":            &params.OpenMembership,
+		"allow_join_requests":        &params.AllowJoinRequests,
+		"events_member_admin":        &params.EventsMemberAdmin,
+		"alerts_member_write":        &params.AlertsMemberWrite,
+		"allow_shared_issues":        &params.AllowSharedIssues,
+		"enhanced_privacy":           &params.EnhancedPrivacy,
+		"data_scrubber":              &params.DataScrubber,
+		"data_scrubber_defaults":     &params.DataScrubberDefaults,
+		"scrub_ip_addresses":         &params.ScrubIPAddresses,
+		"scrape_javascript":          &params.ScrapeJavaScript,
+	}
+	for k, v := range bools {
+		if !config.GetAttr(k).IsNull() {
+			*v = sentry.Bool(d.Get(k).(bool))
+		}
+	}
+
+	strs := map[string]**string{
+		"default_role":     &params.DefaultRole,
+		"attachments_role": &params.AttachmentsRole,
+		"debug_files_role": &params.DebugFilesRole,
+		"relay_pii_config": &params.RelayPiiConfig,
+	}
+	for k, v := range strs {
+		if !config.GetAttr(k).IsNull() {
+			*v = sentry.String(d.Get(k).(string))
+		}
+	}
+
+	lists := map[string]**[]string{
+		"sensitive_fields": &params.SensitiveFields,
+		"safe_fields":      &params.SafeFields,
+	}
+	for k, v := range lists {
+		if !config.GetAttr(k).IsNull() {
+			fields := expandStringList(d.Get(k).([]interface{}))
+			*v = &fields
+		}
+	}
+
+	if !config.GetAttr("store_crash_reports").IsNull() {
+		params.StoreCrashReports = sentry.Int(d.Get("store_crash_reports").(int))
+	}
+}
+
+// hasOrganizationSettings reports whether any organization setting is
+// configured.
+func hasOrganizationSettings(d *schema.ResourceData) bool {
+	config := d.GetRawConfig()
+	for k := range organizationSettingsSchema() {
+		if !config.GetAttr(k).IsNull() {
+			return true
+		}
+	}
+	return false
+}
+
+func flattenOrganizationSettings(d *schema.ResourceData, organization *sentry.Organization) error {
+	relayPiiConfig := sentry.StringValue(organization.RelayPiiConfig)
+	if SuppressEquivalentJSONDiffs("relay_pii_config", d.Get("relay_pii_config").(string), relayPiiConfig, d) {
+		relayPiiConfig = d.Get("relay_pii_config").(string)
+	}
+
+	retErr := multierror.Append(
+		d.Set("is_early_adopter", organization.IsEarlyAdopter),
+		d.Set("require_2fa", organization.Require2FA),
+		d.Set("require_email_verification", organization.RequireEmailVerification),
+		d.Set("default_role", organization.DefaultRole),
+		d.Set("open_membership", organization.OpenMembership),
+		d.Set("allow_join_requests", organization.AllowJoinRequests),
+		d.Set("events_member_admin", organization.EventsMemberAdmin),
+		d.Set("alerts_member_write", organization.AlertsMemberWrite),
+		d.Set("attachments_role", organization.AttachmentsRole),
+		d.Set("debug_files_role", organization.DebugFilesRole),
+		d.Set("allow_shared_issues", organization.AllowSharedIssues),
+		d.Set("enhanced_privacy", organization.EnhancedPrivacy),
+		d.Set("data_scrubber", organization.DataScrubber),
+		d.Set("data_scrubber_defaults", organization.DataScrubberDefaults),
+		d.Set("sensitive_fields", organization.SensitiveFields),
+		d.Set("safe_fields", organization.SafeFields),
+		d.Set("scrub_ip_addresses", organization.ScrubIPAddresses),
+		d.Set("scrape_javascript", organization.ScrapeJavaScript),
+		d.Set("store_crash_reports", organization.StoreCrashReports),
+		d.Set("relay_pii_config", relayPiiConfig),
+	)
+	return retErr.ErrorOrNil()
+}
+
 func resourceSentryOrganizationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*sentry.Client)
 
@@ -63,10 +303,22 @@ func resourceSentryOrganizationCreate(ctx context.Context, d *schema.ResourceDat
 	tflog.Debug(ctx, "Creating organization", map[string]interface{}{"org": params.Name})
 	organization, _, err := client.Organizations.Create(ctx, params)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err, d)
 	}
 
 	d.SetId(sentry.StringValue(organization.Slug))
+
+	// Settings cannot be set when creating an organization.
+	if hasOrganizationSettings(d) {
+		params := &sentry.UpdateOrganizationParams{}
+		expandOrganizationSettings(d, params)
+
+		tflog.Debug(ctx, "Updating organization settings", map[string]interface{}{"org": d.Id()})
+		if _, _, err := client.Organizations.Update(ctx, d.Id(), params); err != nil {
+			return diagFromErr(err, d)
+		}
+	}
+
 	return resourceSentryOrganizationRead(ctx, d, meta)
 }
 
@@ -77,12 +329,10 @@ func resourceSentryOrganizationRead(ctx context.Context, d *schema.ResourceData,
 	tflog.Debug(ctx, "Reading organization", map[string]interface{}{"org": org})
 	organization, _, err := client.Organizations.Get(ctx, org)
 	if err != nil {
-		if sErr, ok := err.(*sentry.ErrorResponse); ok {
-			if sErr.Response.StatusCode == http.StatusNotFound {
-				tflog.Info(ctx, "Removing organization from state because it no longer exists in Sentry", map[string]interface{}{"org": org})
-				d.SetId("")
-				return nil
-			}
+		if isNotFound(err) {
+			tflog.Info(ctx, "Removing organization from state because it no longer exists in Sentry", map[string]interface{}{"org": org})
+			d.SetId("")
+			return nil
 		}
 		return diag.FromErr(err)
 	}
@@ -92,6 +342,7 @@ func resourceSentryOrganizationRead(ctx context.Context, d *schema.ResourceData,
 		d.Set("slug", organization.Slug),
 		d.Set("agree_terms", true),
 		d.Set("internal_id", organization.ID),
+		flattenOrganizationSettings(d, organization),
 	)
 	return diag.FromErr(retErr.ErrorOrNil())
 }
@@ -105,11 +356,12 @@ func resourceSentryOrganizationUpdate(ctx context.Context, d *schema.ResourceDat
 	if slug, ok := d.GetOk("slug"); ok {
 		params.Slug = sentry.String(slug.(string))
 	}
+	expandOrganizationSettings(d, params)
 
 	tflog.Debug(ctx, "Updating organization", map[string]interface{}{"org": org})
 	organization, _, err := client.Organizations.Update(ctx, org, params)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err, d)
 	}
 
 	d.SetId(sentry.StringValue(organization.Slug))
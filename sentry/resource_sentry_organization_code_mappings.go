@@ -88,7 +88,7 @@ func resourceSentryOrganizationCodeMappingCreate(ctx context.Context, d *schema.
 	}
 	orgCodeMapping, _, err := client.OrganizationCodeMappings.Create(ctx, org, params)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err, d)
 	}
 
 	d.SetId(orgCodeMapping.ID)
@@ -114,23 +114,15 @@ func resourceSentryOrganizationCodeMappingRead(ctx context.Context, d *schema.Re
 
 	// get all paginated organization repositories with the query
 	// query does a fuzzy match on name
-	var orgCodeMappings []*sentry.OrganizationCodeMapping
 	params := &sentry.ListOrganizationCodeMappingsParams{
-		ListCursorParams: sentry.ListCursorParams{},
-		IntegrationId:    integrationId,
+		IntegrationId: integrationId,
 	}
-	for {
-		keys, resp, err := client.OrganizationCodeMappings.List(ctx, org, params)
-		if err != nil {
-			return diag.FromErr(err)
-		}
-		orgCodeMappings = append(orgCodeMappings, keys...)
-
-		tflog.Debug(ctx, "Requested organization code mappings list cursor", map[string]interface{}{"cursor": resp.Cursor})
-		if resp.Cursor == "" {
-			break
-		}
-		params.ListCursorParams.Cursor = resp.Cursor
+	orgCodeMappings, err := sentry.ListAll(ctx, nil, func(ctx context.Context, cursor sentry.ListCursorParams) ([]*sentry.OrganizationCodeMapping, *sentry.Response, error) {
+		params.ListCursorParams = cursor
+		return client.OrganizationCodeMappings.List(ctx, org, params)
+	})
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	// filter for first exactly matching name
@@ -173,7 +165,7 @@ func resourceSentryOrganizationCodeMappingUpdate(ctx context.Context, d *schema.
 	})
 	orgCodeMapping, _, err := client.OrganizationCodeMappings.Update(ctx, org, id, params)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err, d)
 	}
 
 	d.SetId(orgCodeMapping.ID)
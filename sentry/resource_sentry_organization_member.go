@@ -39,9 +39,11 @@ func resourceSentryOrganizationMember() *schema.Resource {
 				Required:    true,
 			},
 			"teams": {
-				Description: "The teams the organization member should be added to.",
-				Type:        schema.TypeList,
-				Optional:    true,
+				Description: "The teams the organization member should be added to. " +
+					"When not set, team memberships are left alone, so that they can be managed with `sentry_team_member` instead.",
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 				},
@@ -74,11 +76,11 @@ func resourceSentryOrganizationMemberCreate(ctx context.Context, d *schema.Resou
 		Role:  d.Get("role").(string),
 	}
 
-	if v, ok := d.GetOk("teams"); ok {
-		teams := expandStringList(v.([]interface{}))
-		if len(teams) > 0 {
-			params.Teams = teams
-		}
+	// teams is computed, so only send it when it is configured to avoid
+	// overwriting memberships managed by sentry_team_member.
+	if v := d.GetRawConfig().GetAttr("teams"); !v.IsNull() {
+		teams := expandStringList(d.Get("teams").([]interface{}))
+		params.Teams = &teams
 	}
 
 	tflog.Debug(ctx, "Inviting organization member", map[string]interface{}{
@@ -88,7 +90,7 @@ func resourceSentryOrganizationMemberCreate(ctx context.Context, d *schema.Resou
 	})
 	member, _, err := client.OrganizationMembers.Create(ctx, org, params)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err, d)
 	}
 
 	d.SetId(buildTwoPartID(org, member.ID))
@@ -104,8 +106,8 @@ func resourceSentryOrganizationMemberRead(ctx context.Context, d *schema.Resourc
 		"org":      org,
 		"memberID": memberID,
 	})
-	member, resp, err := client.OrganizationMembers.Get(ctx, org, memberID)
-	if found, err := checkClientGet(resp, err, d); !found {
+	member, _, err := client.OrganizationMembers.Get(ctx, org, memberID)
+	if found, err := checkClientGet(err, d); !found {
 		tflog.Info(ctx, "Removed organization membership from state because it no longer exists in Sentry", map[string]interface{}{
 			"org":      org,
 			"memberID": memberID,
@@ -139,11 +141,9 @@ func resourceSentryOrganizationMemberUpdate(ctx context.Context, d *schema.Resou
 		Role: d.Get("role").(string),
 	}
 
-	if v, ok := d.GetOk("teams"); ok {
-		teams := expandStringList(v.([]interface{}))
-		if len(teams) > 0 {
-			params.Teams = teams
-		}
+	if v := d.GetRawConfig().GetAttr("teams"); !v.IsNull() {
+		teams := expandStringList(d.Get("teams").([]interface{}))
+		params.Teams = &teams
 	}
 
 	tflog.Debug(ctx, "Updating organization member", map[string]interface{}{
@@ -156,7 +156,7 @@ func resourceSentryOrganizationMemberUpdate(ctx context.Context, d *schema.Resou
 
 	member, _, err := client.OrganizationMembers.Update(ctx, org, memberID, params)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err, d)
 	}
 
 	d.SetId(buildTwoPartID(org, member.ID))
@@ -71,7 +71,7 @@ func resourceSentryOrganizationRepositoryGithubCreate(ctx context.Context, d *sc
 	}
 	orgRepo, _, err := client.OrganizationRepositories.Create(ctx, org, params)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err, d)
 	}
 
 	tflog.Debug(ctx, "Created Sentry Github Organization Repository", map[string]interface{}{
@@ -102,23 +102,15 @@ func resourceSentryOrganizationRepositoryGithubRead(ctx context.Context, d *sche
 
 	// get all paginated organization repositories with the query
 	// query does a fuzzy match on name
-	var orgRepos []*sentry.OrganizationRepository
 	params := &sentry.ListOrganizationRepositoriesParams{
-		ListCursorParams: sentry.ListCursorParams{},
-		Query:            id,
+		Query: id,
 	}
-	for {
-		keys, resp, err := client.OrganizationRepositories.List(ctx, org, params)
-		if err != nil {
-			return diag.FromErr(err)
-		}
-		orgRepos = append(orgRepos, keys...)
-
-		tflog.Debug(ctx, "Requested organization repositories list cursor", map[string]interface{}{"cursor": resp.Cursor})
-		if resp.Cursor == "" {
-			break
-		}
-		params.ListCursorParams.Cursor = resp.Cursor
+	orgRepos, err := sentry.ListAll(ctx, nil, func(ctx context.Context, cursor sentry.ListCursorParams) ([]*sentry.OrganizationRepository, *sentry.Response, error) {
+		params.ListCursorParams = cursor
+		return client.OrganizationRepositories.List(ctx, org, params)
+	})
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	tflog.Debug(ctx, "Reading Sentry Github Organization Repository", map[string]interface{}{
@@ -0,0 +1,80 @@
+package sentry
+
+import (
+	"context"
+
+	"github.com/getkevin/terraform-provider-sentry/sentry/lib"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceSentryOrganizationSettings() *schema.Resource {
+	return &schema.Resource{
+		Description: "Sentry Organization Settings resource. Manages the security and privacy settings of an existing " +
+			"organization without creating or deleting it. Settings which are not configured keep their value in Sentry. " +
+			"Destroying this resource only removes it from the Terraform state and leaves the settings unchanged.",
+
+		CreateContext: resourceSentryOrganizationSettingsUpdate,
+		ReadContext:   resourceSentryOrganizationSettingsRead,
+		UpdateContext: resourceSentryOrganizationSettingsUpdate,
+		DeleteContext: resourceSentryOrganizationSettingsDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: mergeSchemas(map[string]*schema.Schema{
+			"organization": {
+				Description: "The slug of the organization to manage the settings for.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+		}, organizationSettingsSchema()),
+	}
+}
+
+func resourceSentryOrganizationSettingsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*sentry.Client)
+	org := d.Id()
+
+	tflog.Debug(ctx, "Reading organization settings", map[string]interface{}{"org": org})
+	organization, _, err := client.Organizations.Get(ctx, org)
+	if found, err := checkClientGet(err, d); !found {
+		tflog.Info(ctx, "Removed organization settings from state because the organization no longer exists in Sentry", map[string]interface{}{"org": org})
+		return diag.FromErr(err)
+	}
+
+	retErr := multierror.Append(
+		d.Set("organization", organization.Slug),
+		flattenOrganizationSettings(d, organization),
+	)
+	return diag.FromErr(retErr.ErrorOrNil())
+}
+
+func resourceSentryOrganizationSettingsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*sentry.Client)
+	org := d.Get("organization").(string)
+
+	params := &sentry.UpdateOrganizationParams{}
+	expandOrganizationSettings(d, params)
+
+	tflog.Debug(ctx, "Updating organization settings", map[string]interface{}{"org": org})
+	organization, _, err := client.Organizations.Update(ctx, org, params)
+	if err != nil {
+		return diagFromErr(err, d)
+	}
+
+	d.SetId(sentry.StringValue(organization.Slug))
+	return resourceSentryOrganizationSettingsRead(ctx, d, meta)
+}
+
+func resourceSentryOrganizationSettingsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "Removing organization settings from state only, the settings are left unchanged in Sentry", map[string]interface{}{
+		"org": d.Id(),
+	})
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,82 @@
+package sentry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/getkevin/terraform-provider-sentry/sentry/lib"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccSentryOrganizationSettings_basic(t *testing.T) {
+	rn := "sentry_organization_settings.test"
+
+	check := func(allowSharedIssues bool, sensitiveFields []string) resource.TestCheckFunc {
+		checks := []resource.TestCheckFunc{
+			testAccCheckSentryOrganizationSettingsExists(rn),
+			resource.TestCheckResourceAttr(rn, "id", testOrganization),
+			resource.TestCheckResourceAttr(rn, "organization", testOrganization),
+			resource.TestCheckResourceAttr(rn, "allow_shared_issues", fmt.Sprintf("%t", allowSharedIssues)),
+			resource.TestCheckResourceAttr(rn, "sensitive_fields.#", fmt.Sprintf("%d", len(sensitiveFields))),
+			resource.TestCheckResourceAttrSet(rn, "require_2fa"),
+			resource.TestCheckResourceAttrSet(rn, "default_role"),
+		}
+		for i, field := range sensitiveFields {
+			checks = append(checks, resource.TestCheckResourceAttr(rn, fmt.Sprintf("sensitive_fields.%d", i), field))
+		}
+		return resource.ComposeTestCheckFunc(checks...)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSentryOrganizationSettingsConfig(false, `["api_key"]`),
+				Check:  check(false, []string{"api_key"}),
+			},
+			{
+				Config: testAccSentryOrganizationSettingsConfig(true, `[]`),
+				Check:  check(true, nil),
+			},
+			{
+				ResourceName:      rn,
+				ImportState:       true,
+				ImportStateId:     testOrganization,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckSentryOrganizationSettingsExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("no ID is set")
+		}
+
+		client := testAccProvider.Meta().(*sentry.Client)
+		ctx := context.Background()
+		_, _, err := client.Organizations.Get(ctx, rs.Primary.ID)
+		return err
+	}
+}
+
+func testAccSentryOrganizationSettingsConfig(allowSharedIssues bool, sensitiveFields string) string {
+	return testAccSentryOrganizationDataSourceConfig + fmt.Sprintf(`
+resource "sentry_organization_settings" "test" {
+	organization = data.sentry_organization.test.id
+
+	allow_shared_issues = %[1]t
+	sensitive_fields    = %[2]s
+}
+	`, allowSharedIssues, sensitiveFields)
+}
@@ -52,6 +52,20 @@ func TestAccSentryOrganization_basic(t *testing.T) {
 				Config: testAccSentryOrganizationConfig(orgName + "-renamed"),
 				Check:  check(orgName + "-renamed"),
 			},
+			{
+				Config: testAccSentryOrganizationConfig_settings(orgName + "-renamed"),
+				Check: resource.ComposeTestCheckFunc(
+					check(orgName+"-renamed"),
+					resource.TestCheckResourceAttr(rn, "scrub_ip_addresses", "true"),
+					resource.TestCheckResourceAttr(rn, "open_membership", "false"),
+					resource.TestCheckResourceAttr(rn, "data_scrubber", "true"),
+					resource.TestCheckResourceAttr(rn, "sensitive_fields.#", "2"),
+					resource.TestCheckResourceAttr(rn, "sensitive_fields.0", "api_key"),
+					resource.TestCheckResourceAttr(rn, "safe_fields.#", "0"),
+					resource.TestCheckResourceAttr(rn, "store_crash_reports", "5"),
+					resource.TestCheckResourceAttr(rn, "attachments_role", "admin"),
+				),
+			},
 			{
 				ResourceName:      rn,
 				ImportState:       true,
@@ -117,3 +131,21 @@ resource "sentry_organization" "test_organization" {
 }
 	`, orgName)
 }
+
+func testAccSentryOrganizationConfig_settings(orgName string) string {
+	return fmt.Sprintf(`
+resource "sentry_organization" "test_organization" {
+	name = "%[1]s"
+
+	agree_terms = true
+
+	open_membership     = false
+	scrub_ip_addresses  = true
+	data_scrubber       = true
+	sensitive_fields    = ["api_key", "ssn"]
+	safe_fields         = []
+	store_crash_reports = 5
+	attachments_role    = "admin"
+}
+	`, orgName)
+}
@@ -2,10 +2,10 @@ package sentry
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
-	"net/http"
-	"strings"
+	"strconv"
 
 	"github.com/getkevin/terraform-provider-sentry/sentry/lib"
 	"github.com/hashicorp/go-cty/cty"
@@ -62,7 +62,9 @@ func resourceSentryProject() *schema.Resource {
 				Computed:    true,
 			},
 			"platform": {
-				Description:      "The optional platform for this project.",
+				Description: "The optional platform for this project. Platforms are validated against a list embedded in the " +
+					"provider. Set the `SENTRY_REMOTE_PLATFORM_VALIDATION` environment variable to `true` to also accept " +
+					"platforms which are documented on docs.sentry.io but not yet known to the provider.",
 				Type:             schema.TypeString,
 				Optional:         true,
 				Computed:         true,
@@ -121,7 +123,115 @@ func resourceSentryProject() *schema.Resource {
 				Type:        schema.TypeString,
 				Computed:    true,
 			},
-			// TODO: Project options
+			"subject_prefix": {
+				Description: "The prefix of the subject of email notifications.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			"subject_template": {
+				Description: "The template of the subject of email notifications, e.g. `$shortID - $title`.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			"allowed_domains": {
+				Description: "The domains which are allowed to send events to the project, e.g. `*.example.com`. " +
+					"`*` allows all domains.",
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"data_scrubber": {
+				Description: "Enable server-side data scrubbing.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+			"data_scrubber_defaults": {
+				Description: "Apply the default data scrubbers, e.g. for credit card numbers and passwords.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+			"sensitive_fields": {
+				Description: "Additional field names to scrub.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"safe_fields": {
+				Description: "Field names which data scrubbers ignore.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"scrub_ip_addresses": {
+				Description: "Prevent IP addresses from being stored for new events.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+			"scrape_javascript": {
+				Description: "Allow Sentry to scrape missing JavaScript source context when possible.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+			"security_token": {
+				Description: "The token Sentry sends when scraping source files, to tell its requests apart from others.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"security_token_header": {
+				Description: "The header Sentry sends the `security_token` in, e.g. `X-Sentry-Token`.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			"verify_ssl": {
+				Description: "Verify the TLS certificates of servers Sentry scrapes source files from.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+			"fingerprinting_rules": {
+				Description: "The [fingerprinting rules](https://docs.sentry.io/product/data-management-settings/event-grouping/fingerprint-rules/) " +
+					"of the project. Leading and trailing whitespace is ignored.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				DiffSuppressFunc: suppressSurroundingWhitespaceDiffs,
+			},
+			"grouping_enhancements": {
+				Description: "The [stack trace rules](https://docs.sentry.io/product/data-management-settings/event-grouping/stack-trace-rules/) " +
+					"of the project. Leading and trailing whitespace is ignored.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				DiffSuppressFunc: suppressSurroundingWhitespaceDiffs,
+			},
+			"options": {
+				Description: "Additional project options, e.g. `sentry:token_header`. Values are sent as JSON if they " +
+					"parse as JSON, e.g. `true` or `720`, and as strings otherwise. Only the configured options are " +
+					"tracked, and removing an option leaves its value unchanged in Sentry.",
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 		},
 	}
 }
@@ -160,7 +270,7 @@ func resourceSentryProjectCreate(ctx context.Context, d *schema.ResourceData, me
 	})
 	proj, _, err := client.Projects.Create(ctx, org, initialTeam, params)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err, d)
 	}
 	tflog.Debug(ctx, "Created Sentry project", map[string]interface{}{
 		"projectSlug": proj.Slug,
@@ -183,8 +293,8 @@ func resourceSentryProjectRead(ctx context.Context, d *schema.ResourceData, meta
 		"projectSlug": slug,
 		"org":         org,
 	})
-	proj, resp, err := client.Projects.Get(ctx, org, slug)
-	if found, err := checkClientGet(resp, err, d); !found {
+	proj, _, err := client.Projects.Get(ctx, org, slug)
+	if found, err := checkClientGet(err, d); !found {
 		return diag.FromErr(err)
 	}
 	tflog.Debug(ctx, "Read Sentry project", map[string]interface{}{
@@ -219,7 +329,23 @@ func resourceSentryProjectRead(ctx context.Context, d *schema.ResourceData, meta
 		retErr = multierror.Append(retErr, d.Set("teams", flattenStringSet(teams)))
 	}
 
-	// TODO: Project options
+	retErr = multierror.Append(retErr,
+		d.Set("subject_prefix", proj.SubjectPrefix),
+		d.Set("subject_template", proj.SubjectTemplate),
+		d.Set("allowed_domains", flattenStringSet(proj.AllowedDomains)),
+		d.Set("data_scrubber", proj.DataScrubber),
+		d.Set("data_scrubber_defaults", proj.DataScrubberDefaults),
+		d.Set("sensitive_fields", proj.SensitiveFields),
+		d.Set("safe_fields", proj.SafeFields),
+		d.Set("scrub_ip_addresses", proj.ScrubIPAddresses),
+		d.Set("scrape_javascript", proj.ScrapeJavaScript),
+		d.Set("security_token", proj.SecurityToken),
+		d.Set("security_token_header", proj.SecurityTokenHeader),
+		d.Set("verify_ssl", proj.VerifySSL),
+		d.Set("fingerprinting_rules", proj.FingerprintingRules),
+		d.Set("grouping_enhancements", proj.GroupingEnhancements),
+		d.Set("options", flattenProjectOptions(d.Get("options").(map[string]interface{}), proj.Options)),
+	)
 
 	return diag.FromErr(retErr.ErrorOrNil())
 }
@@ -251,13 +377,15 @@ func resourceSentryProjectUpdate(ctx context.Context, d *schema.ResourceData, me
 		params.ResolveAge = sentry.Int(v.(int))
 	}
 
+	expandProjectSettings(d, params)
+
 	tflog.Debug(ctx, "Updating project", map[string]interface{}{
 		"org":     org,
 		"project": project,
 	})
 	proj, _, err := client.Projects.Update(ctx, org, project, params)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err, d)
 	}
 
 	d.SetId(proj.Slug)
@@ -303,7 +431,7 @@ func resourceSentryProjectUpdate(ctx context.Context, d *schema.ResourceData, me
 		for newTeam := range newTeams {
 			_, _, err = client.Projects.AddTeam(ctx, org, project, newTeam)
 			if err != nil {
-				return diag.FromErr(err)
+				return diagFromErr(err, d)
 			}
 		}
 	}
@@ -316,11 +444,9 @@ func resourceSentryProjectUpdate(ctx context.Context, d *schema.ResourceData, me
 		})
 
 		for oldTeam := range oldTeams {
-			resp, err := client.Projects.RemoveTeam(ctx, org, project, oldTeam)
-			if err != nil {
-				if resp.Response.StatusCode != http.StatusNotFound {
-					return diag.FromErr(err)
-				}
+			_, err := client.Projects.RemoveTeam(ctx, org, project, oldTeam)
+			if err != nil && !isNotFound(err) {
+				return diag.FromErr(err)
 			}
 		}
 	}
@@ -347,44 +473,133 @@ func resourceSentryProjectDelete(ctx context.Context, d *schema.ResourceData, me
 	return diag.FromErr(err)
 }
 
-func validatePlatform(i interface{}, path cty.Path) diag.Diagnostics {
-	var diagnostics diag.Diagnostics
+// expandProjectSettings sets the configured project settings on params.
+func expandProjectSettings(d *schema.ResourceData, params *sentry.UpdateProjectParams) {
+	config := d.GetRawConfig()
+	isSet := func(k string) bool {
+		return !config.GetAttr(k).IsNull()
+	}
 
-	v := i.(string)
-	if v == "other" {
-		return nil
+	strs := map[string]**string{
+		"subject_prefix":        &params.SubjectPrefix,
+		"subject_template":      &params.SubjectTemplate,
+		"security_token":        &params.SecurityToken,
+		"security_token_header": &params.SecurityTokenHeader,
+		"fingerprinting_rules":  &params.FingerprintingRules,
+		"grouping_enhancements": &params.GroupingEnhancements,
+	}
+	for k, v := range strs {
+		if isSet(k) {
+			*v = sentry.String(d.Get(k).(string))
+		}
 	}
 
-	urls := []string{
-		fmt.Sprintf("https://docs.sentry.io/_platforms/%s.json", v),
-		fmt.Sprintf(
-			"https://docs.sentry.io/_platforms/%s.json",
-			strings.Replace(v, "-", "/", 1),
-		),
+	bools := map[string]**bool{
+		"data_scrubber":          &params.DataScrubber,
+		"data_scrubber_defaults": &params.DataScrubberDefaults,
+		"scrub_ip_addresses":     &params.ScrubIPAddresses,
+		"scrape_javascript":      &params.ScrapeJavaScript,
+		"verify_ssl":             &params.VerifySSL,
+	}
+	for k, v := range bools {
+		if isSet(k) {
+			*v = sentry.Bool(d.Get(k).(bool))
+		}
 	}
 
-	for _, url := range urls {
-		resp, err := http.Get(url)
+	if isSet("allowed_domains") {
+		domains := expandStringList(d.Get("allowed_domains").(*schema.Set).List())
+		params.AllowedDomains = &domains
+	}
+	if isSet("sensitive_fields") {
+		fields := expandStringList(d.Get("sensitive_fields").([]interface{}))
+		params.SensitiveFields = &fields
+	}
+	if isSet("safe_fields") {
+		fields := expandStringList(d.Get("safe_fields").([]interface{}))
+		params.SafeFields = &fields
+	}
+
+	if v, ok := d.GetOk("options"); ok {
+		params.Options = expandProjectOptions(v.(map[string]interface{}))
+	}
+}
 
+// expandProjectOptions converts the configured options to the values sent to
+// Sentry. Values which parse as JSON, such as booleans and numbers, are sent
+// as such, and other values as strings.
+func expandProjectOptions(configured map[string]interface{}) map[string]interface{} {
+	options := make(map[string]interface{}, len(configured))
+	for k, v := range configured {
+		s := v.(string)
+		var value interface{}
+		if err := json.Unmarshal([]byte(s), &value); err != nil {
+			value = s
+		}
+		options[k] = value
+	}
+	return options
+}
+
+// flattenProjectOptions returns the values of the configured options from the
+// options of a project. Other options are left out, as projects have many
+// options which are not managed by Terraform.
+func flattenProjectOptions(configured map[string]interface{}, options map[string]interface{}) map[string]string {
+	flattened := make(map[string]string, len(configured))
+	for k := range configured {
+		v, ok := options[k]
+		if !ok || v == nil {
+			continue
+		}
+		switch v := v.(type) {
+		case string:
+			flattened[k] = v
+		case json.Number:
+			flattened[k] = v.String()
+		case bool:
+			flattened[k] = strconv.FormatBool(v)
+		default:
+			b, err := json.Marshal(v)
+			if err != nil {
+				continue
+			}
+			flattened[k] = string(b)
+		}
+	}
+	return flattened
+}
+
+func validatePlatform(i interface{}, path cty.Path) diag.Diagnostics {
+	v := i.(string)
+	if isKnownPlatform(v) {
+		return nil
+	}
+
+	if remotePlatformValidationEnabled() {
+		ok, err := isRemotePlatform(v)
+		if ok {
+			return nil
+		}
 		if err != nil {
 			msg := "could not validate the platform at this time"
-			diagnostics = append(diagnostics, diag.Diagnostic{
+			return diag.Diagnostics{{
 				Severity:      diag.Error,
 				Summary:       msg,
-				Detail:        msg,
+				Detail:        fmt.Sprintf("%s: %s", msg, err),
 				AttributePath: path,
-			})
-		} else if resp.StatusCode == 200 {
-			return nil
+			}}
 		}
 	}
 
 	msg := fmt.Sprintf("%s is not a valid platform", v)
-	diagnostics = append(diagnostics, diag.Diagnostic{
+	detail := msg
+	if suggestion := suggestPlatform(v); suggestion != "" {
+		detail = fmt.Sprintf("%s, did you mean %s?", msg, suggestion)
+	}
+	return diag.Diagnostics{{
 		Severity:      diag.Error,
 		Summary:       msg,
-		Detail:        msg,
+		Detail:        detail,
 		AttributePath: path,
-	})
-	return diagnostics
+	}}
 }
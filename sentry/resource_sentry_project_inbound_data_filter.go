@@ -0,0 +1,172 @@
+package sentry
+
+import (
+	"context"
+
+	"github.com/getkevin/terraform-provider-sentry/sentry/lib"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceSentryProjectInboundDataFilter() *schema.Resource {
+	return &schema.Resource{
+		Description: "Sentry Project Inbound Data Filter resource. This resource is used to create and manage inbound data filters for a project. " +
+			"For more information on what filters are available, see the " +
+			"[Sentry documentation](https://docs.sentry.io/api/projects/update-an-inbound-data-filter/).",
+
+		CreateContext: resourceSentryProjectInboundDataFilterUpdate,
+		ReadContext:   resourceSentryProjectInboundDataFilterRead,
+		UpdateContext: resourceSentryProjectInboundDataFilterUpdate,
+		DeleteContext: resourceSentryProjectInboundDataFilterDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"organization": {
+				Description: "The slug of the organization the project belongs to.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"project": {
+				Description: "The slug of the project to create the filter for.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"filter_id": {
+				Description: "The type of filter toggle to update. See the " +
+					"[Sentry documentation](https://docs.sentry.io/api/projects/update-an-inbound-data-filter/) " +
+					"for a list of available filters.",
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"active": {
+				Description:   "Toggle the browser-extensions, localhost, filtered-transaction, or web-crawlers filter on or off.",
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"subfilters"},
+			},
+			"subfilters": {
+				Description: "Specifies which legacy browser filters should be active. Anything excluded from the list will be disabled. See the " +
+					"[Sentry documentation](https://docs.sentry.io/api/projects/update-an-inbound-data-filter/) " +
+					"for a list of available subfilters.",
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				ConflictsWith: []string{"active"},
+			},
+		},
+	}
+}
+
+func resourceSentryProjectInboundDataFilterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*sentry.Client)
+
+	org, project, filterID, err := splitSentryProjectInboundDataFilterID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	tflog.Debug(ctx, "Reading project inbound data filter", map[string]interface{}{
+		"org":      org,
+		"project":  project,
+		"filterID": filterID,
+	})
+	filters, _, err := client.ProjectFilter.Get(ctx, org, project)
+	if found, err := checkClientGet(err, d); !found {
+		tflog.Info(ctx, "Removed project inbound data filter from state because the project no longer exists in Sentry", map[string]interface{}{
+			"org":     org,
+			"project": project,
+		})
+		return diag.FromErr(err)
+	}
+
+	for _, filter := range filters {
+		if filter.ID != filterID {
+			continue
+		}
+
+		retErr := multierror.Append(
+			d.Set("organization", org),
+			d.Set("project", project),
+			d.Set("filter_id", filter.ID),
+			d.Set("active", filter.Active.Enabled),
+			d.Set("subfilters", flattenStringSet(filter.Active.Subfilters)),
+		)
+		return diag.FromErr(retErr.ErrorOrNil())
+	}
+
+	return diag.Errorf("inbound data filter %q is not available for project %q", filterID, project)
+}
+
+func resourceSentryProjectInboundDataFilterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*sentry.Client)
+
+	org := d.Get("organization").(string)
+	project := d.Get("project").(string)
+	filterID := d.Get("filter_id").(string)
+
+	// Both attributes are computed, so look at the configuration to decide
+	// whether the filter is toggled or configured with subfilters.
+	params := &sentry.UpdateProjectFilterParams{}
+	if v := d.GetRawConfig().GetAttr("subfilters"); !v.IsNull() {
+		params.Subfilters = expandStringList(d.Get("subfilters").(*schema.Set).List())
+	} else {
+		params.Active = sentry.Bool(d.Get("active").(bool))
+	}
+
+	tflog.Debug(ctx, "Updating project inbound data filter", map[string]interface{}{
+		"org":      org,
+		"project":  project,
+		"filterID": filterID,
+	})
+	_, err := client.ProjectFilter.Update(ctx, org, project, filterID, params)
+	if err != nil {
+		return diagFromErr(err, d)
+	}
+
+	d.SetId(buildThreePartID(org, project, filterID))
+	return resourceSentryProjectInboundDataFilterRead(ctx, d, meta)
+}
+
+func resourceSentryProjectInboundDataFilterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*sentry.Client)
+
+	org, project, filterID, err := splitSentryProjectInboundDataFilterID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Filters cannot be deleted, so turn them off instead. Sentry ignores
+	// active for filters with subfilters, which are turned off by disabling
+	// every subfilter.
+	params := &sentry.UpdateProjectFilterParams{
+		Active: sentry.Bool(false),
+	}
+	if filterID == "legacy-browsers" || d.Get("subfilters").(*schema.Set).Len() > 0 {
+		params.Subfilters = []string{}
+	}
+
+	tflog.Debug(ctx, "Deleting project inbound data filter", map[string]interface{}{
+		"org":      org,
+		"project":  project,
+		"filterID": filterID,
+	})
+	_, err = client.ProjectFilter.Update(ctx, org, project, filterID, params)
+	return diagFromErr(err, d)
+}
+
+func splitSentryProjectInboundDataFilterID(id string) (org string, project string, filterID string, err error) {
+	org, project, filterID, err = splitThreePartID(id, "organization-slug", "project-slug", "filter-id")
+	return
+}
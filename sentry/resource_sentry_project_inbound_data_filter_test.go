@@ -0,0 +1,221 @@
+package sentry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/getkevin/terraform-provider-sentry/sentry/lib"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccSentryProjectInboundDataFilter_basic(t *testing.T) {
+	teamName := acctest.RandomWithPrefix("tf-team")
+	projectName := acctest.RandomWithPrefix("tf-project")
+	rn := "sentry_project_inbound_data_filter.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckSentryProjectInboundDataFilterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSentryProjectInboundDataFilterConfig_active(teamName, projectName, "browser-extensions", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSentryProjectInboundDataFilterExists(rn),
+					resource.TestCheckResourceAttr(rn, "filter_id", "browser-extensions"),
+					resource.TestCheckResourceAttr(rn, "active", "true"),
+					resource.TestCheckResourceAttr(rn, "subfilters.#", "0"),
+				),
+			},
+			{
+				Config: testAccSentryProjectInboundDataFilterConfig_active(teamName, projectName, "browser-extensions", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSentryProjectInboundDataFilterExists(rn),
+					resource.TestCheckResourceAttr(rn, "active", "false"),
+				),
+			},
+			{
+				ResourceName:      rn,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSentryProjectInboundDataFilter_subfilters(t *testing.T) {
+	teamName := acctest.RandomWithPrefix("tf-team")
+	projectName := acctest.RandomWithPrefix("tf-project")
+	rn := "sentry_project_inbound_data_filter.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckSentryProjectInboundDataFilterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSentryProjectInboundDataFilterConfig_subfilters(teamName, projectName, `["ie_pre_9", "ie9"]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSentryProjectInboundDataFilterExists(rn),
+					resource.TestCheckResourceAttr(rn, "filter_id", "legacy-browsers"),
+					resource.TestCheckResourceAttr(rn, "active", "true"),
+					resource.TestCheckResourceAttr(rn, "subfilters.#", "2"),
+					resource.TestCheckTypeSetElemAttr(rn, "subfilters.*", "ie_pre_9"),
+					resource.TestCheckTypeSetElemAttr(rn, "subfilters.*", "ie9"),
+				),
+			},
+			{
+				Config: testAccSentryProjectInboundDataFilterConfig_subfilters(teamName, projectName, `[]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSentryProjectInboundDataFilterExists(rn),
+					resource.TestCheckResourceAttr(rn, "active", "false"),
+					resource.TestCheckResourceAttr(rn, "subfilters.#", "0"),
+				),
+			},
+			{
+				ResourceName:      rn,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSentryProjectInboundDataFilter_subfiltersDestroy(t *testing.T) {
+	teamName := acctest.RandomWithPrefix("tf-team")
+	projectName := acctest.RandomWithPrefix("tf-project")
+	rn := "sentry_project_inbound_data_filter.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckSentryProjectInboundDataFilterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSentryProjectInboundDataFilterConfig_subfilters(teamName, projectName, `["ie_pre_9", "ie9"]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSentryProjectInboundDataFilterExists(rn),
+					resource.TestCheckResourceAttr(rn, "active", "true"),
+				),
+			},
+			{
+				Config: testAccSentryProjectConfig_team(teamName, projectName),
+				Check:  testAccCheckSentryProjectInboundDataFilterDisabled("sentry_project.test", "legacy-browsers"),
+			},
+		},
+	})
+}
+
+func testAccCheckSentryProjectInboundDataFilterDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*sentry.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "sentry_project_inbound_data_filter" {
+			continue
+		}
+
+		org, project, filterID, err := splitSentryProjectInboundDataFilterID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		ctx := context.Background()
+		filters, resp, err := client.ProjectFilter.Get(ctx, org, project)
+		if err == nil {
+			for _, filter := range filters {
+				if filter.ID == filterID && filter.Active.Enabled {
+					return errors.New("inbound data filter is still active")
+				}
+			}
+			return nil
+		}
+		if resp.StatusCode != 404 {
+			return err
+		}
+		return nil
+	}
+	return nil
+}
+
+func testAccCheckSentryProjectInboundDataFilterExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("no ID is set")
+		}
+
+		org, project, filterID, err := splitSentryProjectInboundDataFilterID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*sentry.Client)
+		ctx := context.Background()
+		filters, _, err := client.ProjectFilter.Get(ctx, org, project)
+		if err != nil {
+			return err
+		}
+		for _, filter := range filters {
+			if filter.ID == filterID {
+				return nil
+			}
+		}
+		return fmt.Errorf("not found: %s", n)
+	}
+}
+
+func testAccCheckSentryProjectInboundDataFilterDisabled(projectResourceName, filterID string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[projectResourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", projectResourceName)
+		}
+
+		client := testAccProvider.Meta().(*sentry.Client)
+		ctx := context.Background()
+		filters, _, err := client.ProjectFilter.Get(ctx, rs.Primary.Attributes["organization"], rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		for _, filter := range filters {
+			if filter.ID != filterID {
+				continue
+			}
+			if filter.Active.Enabled {
+				return fmt.Errorf("inbound data filter is still active: %v", filter.Active.Subfilters)
+			}
+			return nil
+		}
+		return fmt.Errorf("inbound data filter not found: %s", filterID)
+	}
+}
+
+func testAccSentryProjectInboundDataFilterConfig_active(teamName, projectName, filterID string, active bool) string {
+	return testAccSentryProjectConfig_team(teamName, projectName) + fmt.Sprintf(`
+resource "sentry_project_inbound_data_filter" "test" {
+	organization = sentry_project.test.organization
+	project      = sentry_project.test.id
+	filter_id    = "%[1]s"
+	active       = %[2]t
+}
+	`, filterID, active)
+}
+
+func testAccSentryProjectInboundDataFilterConfig_subfilters(teamName, projectName, subfilters string) string {
+	return testAccSentryProjectConfig_team(teamName, projectName) + fmt.Sprintf(`
+resource "sentry_project_inbound_data_filter" "test" {
+	organization = sentry_project.test.organization
+	project      = sentry_project.test.id
+	filter_id    = "legacy-browsers"
+	subfilters   = %[1]s
+}
+	`, subfilters)
+}
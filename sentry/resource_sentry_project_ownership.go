@@ -0,0 +1,208 @@
+package sentry
+
+import (
+	"context"
+	"strings"
+
+	"github.com/getkevin/terraform-provider-sentry/sentry/lib"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceSentryProjectOwnership() *schema.Resource {
+	return &schema.Resource{
+		Description: "Sentry Project Ownership resource. Manages the ownership rules of a project. " +
+			"Deleting this resource clears the ownership rules of the project.",
+
+		CreateContext: resourceSentryProjectOwnershipCreate,
+		ReadContext:   resourceSentryProjectOwnershipRead,
+		UpdateContext: resourceSentryProjectOwnershipUpdate,
+		DeleteContext: resourceSentryProjectOwnershipDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"organization": {
+				Description: "The slug of the organization the project belongs to.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"project": {
+				Description: "The slug of the project to manage the ownership rules for.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"raw": {
+				Description: "Raw input for ownership configuration. See the " +
+					"[Sentry documentation](https://docs.sentry.io/product/issues/ownership-rules/) " +
+					"for the syntax. Differences in whitespace are ignored.",
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: suppressEquivalentOwnershipRules,
+			},
+			"fallthrough": {
+				Description: "Whether issues that do not match any ownership rule are sent to all project members.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+			"auto_assignment": {
+				Description: "Whether issues are automatically assigned to the owners matched by the rules.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+			"codeowners_auto_sync": {
+				Description: "Whether CODEOWNERS files are automatically synced with the ownership rules.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+			"is_active": {
+				Description: "Whether the ownership configuration is active.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceSentryProjectOwnershipParams(d *schema.ResourceData) *sentry.UpdateProjectOwnershipParams {
+	params := &sentry.UpdateProjectOwnershipParams{
+		Raw: sentry.String(d.Get("raw").(string)),
+	}
+	if v := d.GetRawConfig().GetAttr("fallthrough"); !v.IsNull() {
+		params.FallThrough = sentry.Bool(v.True())
+	}
+	if v := d.GetRawConfig().GetAttr("auto_assignment"); !v.IsNull() {
+		params.AutoAssignment = sentry.Bool(v.True())
+	}
+	if v := d.GetRawConfig().GetAttr("codeowners_auto_sync"); !v.IsNull() {
+		params.CodeownersAutoSync = sentry.Bool(v.True())
+	}
+	return params
+}
+
+func resourceSentryProjectOwnershipCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*sentry.Client)
+
+	org := d.Get("organization").(string)
+	project := d.Get("project").(string)
+	params := resourceSentryProjectOwnershipParams(d)
+
+	tflog.Debug(ctx, "Creating project ownership", map[string]interface{}{
+		"org":     org,
+		"project": project,
+	})
+	_, _, err := client.ProjectOwnerships.Update(ctx, org, project, params)
+	if err != nil {
+		return diagFromErr(err, d)
+	}
+
+	d.SetId(buildTwoPartID(org, project))
+	return resourceSentryProjectOwnershipRead(ctx, d, meta)
+}
+
+func resourceSentryProjectOwnershipRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*sentry.Client)
+
+	org, project, err := splitSentryProjectOwnershipID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	tflog.Debug(ctx, "Reading project ownership", map[string]interface{}{
+		"org":     org,
+		"project": project,
+	})
+	ownership, _, err := client.ProjectOwnerships.Get(ctx, org, project)
+	if found, err := checkClientGet(err, d); !found {
+		tflog.Info(ctx, "Removed project ownership from state because the project no longer exists in Sentry", map[string]interface{}{
+			"org":     org,
+			"project": project,
+		})
+		return diagFromErr(err, d)
+	}
+
+	retErr := multierror.Append(
+		d.Set("organization", org),
+		d.Set("project", project),
+		d.Set("raw", ownership.Raw),
+		d.Set("fallthrough", ownership.FallThrough),
+		d.Set("auto_assignment", ownership.AutoAssignment),
+		d.Set("codeowners_auto_sync", ownership.CodeownersAutoSync),
+		d.Set("is_active", ownership.IsActive),
+	)
+	return diag.FromErr(retErr.ErrorOrNil())
+}
+
+func resourceSentryProjectOwnershipUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*sentry.Client)
+
+	org, project, err := splitSentryProjectOwnershipID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	params := resourceSentryProjectOwnershipParams(d)
+
+	tflog.Debug(ctx, "Updating project ownership", map[string]interface{}{
+		"org":     org,
+		"project": project,
+	})
+	_, _, err = client.ProjectOwnerships.Update(ctx, org, project, params)
+	if err != nil {
+		return diagFromErr(err, d)
+	}
+
+	return resourceSentryProjectOwnershipRead(ctx, d, meta)
+}
+
+func resourceSentryProjectOwnershipDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*sentry.Client)
+
+	org, project, err := splitSentryProjectOwnershipID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Ownership cannot be deleted, so clear the rules instead.
+	params := &sentry.UpdateProjectOwnershipParams{
+		Raw: sentry.String(""),
+	}
+
+	tflog.Debug(ctx, "Deleting project ownership", map[string]interface{}{
+		"org":     org,
+		"project": project,
+	})
+	_, _, err = client.ProjectOwnerships.Update(ctx, org, project, params)
+	return diagFromErr(err, d)
+}
+
+func splitSentryProjectOwnershipID(id string) (org string, project string, err error) {
+	org, project, err = splitTwoPartID(id, "organization-slug", "project-slug")
+	return
+}
+
+// normalizeOwnershipRules trims each line of the rules, collapses repeated
+// whitespace and drops blank lines, so that rules only differing in layout
+// compare equal.
+func normalizeOwnershipRules(raw string) string {
+	lines := strings.Split(raw, "\n")
+	normalized := make([]string, 0, len(lines))
+	for _, line := range lines {
+		if fields := strings.Fields(line); len(fields) > 0 {
+			normalized = append(normalized, strings.Join(fields, " "))
+		}
+	}
+	return strings.Join(normalized, "\n")
+}
+
+func suppressEquivalentOwnershipRules(k, old, new string, d *schema.ResourceData) bool {
+	return normalizeOwnershipRules(old) == normalizeOwnershipRules(new)
+}
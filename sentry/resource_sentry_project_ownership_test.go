@@ -0,0 +1,155 @@
+package sentry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/getkevin/terraform-provider-sentry/sentry/lib"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccSentryProjectOwnership_basic(t *testing.T) {
+	teamName := acctest.RandomWithPrefix("tf-team")
+	projectName := acctest.RandomWithPrefix("tf-project")
+	rn := "sentry_project_ownership.test"
+
+	check := func(raw string, fallThrough bool) resource.TestCheckFunc {
+		return resource.ComposeTestCheckFunc(
+			testAccCheckSentryProjectOwnershipExists(rn),
+			resource.TestCheckResourceAttrPair(rn, "organization", "sentry_project.test", "organization"),
+			resource.TestCheckResourceAttrPair(rn, "project", "sentry_project.test", "id"),
+			resource.TestCheckResourceAttr(rn, "raw", raw),
+			resource.TestCheckResourceAttr(rn, "fallthrough", fmt.Sprintf("%t", fallThrough)),
+			resource.TestCheckResourceAttrSet(rn, "auto_assignment"),
+			resource.TestCheckResourceAttrSet(rn, "is_active"),
+		)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckSentryProjectOwnershipDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSentryProjectOwnershipConfig(teamName, projectName, "path:src/* #"+teamName, false),
+				Check:  check("path:src/* #"+teamName, false),
+			},
+			{
+				Config: testAccSentryProjectOwnershipConfig(teamName, projectName, "path:lib/* #"+teamName, true),
+				Check:  check("path:lib/* #"+teamName, true),
+			},
+			{
+				Config:   testAccSentryProjectOwnershipConfig(teamName, projectName, "\n  path:lib/*   #"+teamName+"  \n", true),
+				PlanOnly: true,
+			},
+			{
+				ResourceName:      rn,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestNormalizeOwnershipRules(t *testing.T) {
+	testCases := []struct {
+		name string
+		raw  string
+		want string
+	}{
+		{
+			name: "empty",
+			raw:  "",
+			want: "",
+		},
+		{
+			name: "already normalized",
+			raw:  "path:src/* #team\nurl:*/checkout/* user@example.com",
+			want: "path:src/* #team\nurl:*/checkout/* user@example.com",
+		},
+		{
+			name: "surrounding whitespace and blank lines",
+			raw:  "\n  path:src/*   #team \n\n\turl:*/checkout/*  user@example.com\n",
+			want: "path:src/* #team\nurl:*/checkout/* user@example.com",
+		},
+		{
+			name: "windows line endings",
+			raw:  "path:src/* #team\r\nurl:*/checkout/* user@example.com\r\n",
+			want: "path:src/* #team\nurl:*/checkout/* user@example.com",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := normalizeOwnershipRules(tc.raw); got != tc.want {
+				t.Errorf("got %q; want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func testAccCheckSentryProjectOwnershipDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*sentry.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "sentry_project_ownership" {
+			continue
+		}
+
+		org, project, err := splitSentryProjectOwnershipID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		ctx := context.Background()
+		ownership, resp, err := client.ProjectOwnerships.Get(ctx, org, project)
+		if err == nil {
+			if ownership != nil && ownership.Raw != "" {
+				return errors.New("project ownership rules still exist")
+			}
+			return nil
+		}
+		if resp.StatusCode != 404 {
+			return err
+		}
+		return nil
+	}
+	return nil
+}
+
+func testAccCheckSentryProjectOwnershipExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("no ID is set")
+		}
+
+		org, project, err := splitSentryProjectOwnershipID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*sentry.Client)
+		ctx := context.Background()
+		_, _, err = client.ProjectOwnerships.Get(ctx, org, project)
+		return err
+	}
+}
+
+func testAccSentryProjectOwnershipConfig(teamName, projectName, raw string, fallThrough bool) string {
+	return testAccSentryProjectConfig_team(teamName, projectName) + fmt.Sprintf(`
+resource "sentry_project_ownership" "test" {
+	organization = sentry_project.test.organization
+	project      = sentry_project.test.id
+	raw          = %[1]q
+	fallthrough  = %[2]t
+}
+	`, raw, fallThrough)
+}
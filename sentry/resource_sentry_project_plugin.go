@@ -61,7 +61,7 @@ func resourceSentryPluginCreate(ctx context.Context, d *schema.ResourceData, met
 	})
 	_, err := client.ProjectPlugins.Enable(ctx, org, project, plugin)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err, d)
 	}
 	tflog.Debug(ctx, "Created Sentry plugin", map[string]interface{}{
 		"pluginName": plugin,
@@ -73,7 +73,7 @@ func resourceSentryPluginCreate(ctx context.Context, d *schema.ResourceData, met
 
 	params := d.Get("config").(map[string]interface{})
 	if _, _, err := client.ProjectPlugins.Update(ctx, org, project, plugin, params); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err, d)
 	}
 
 	return resourceSentryPluginRead(ctx, d, meta)
@@ -91,8 +91,8 @@ func resourceSentryPluginRead(ctx context.Context, d *schema.ResourceData, meta
 		"org":      org,
 		"project":  project,
 	})
-	plugin, resp, err := client.ProjectPlugins.Get(ctx, org, project, id)
-	if found, err := checkClientGet(resp, err, d); !found {
+	plugin, _, err := client.ProjectPlugins.Get(ctx, org, project, id)
+	if found, err := checkClientGet(err, d); !found {
 		return diag.FromErr(err)
 	}
 	tflog.Debug(ctx, "Read Sentry plugin", map[string]interface{}{
@@ -136,7 +136,7 @@ func resourceSentryPluginUpdate(ctx context.Context, d *schema.ResourceData, met
 	params := d.Get("config").(map[string]interface{})
 	plugin, _, err := client.ProjectPlugins.Update(ctx, org, project, id, params)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err, d)
 	}
 	tflog.Debug(ctx, "Updated Sentry plugin", map[string]interface{}{
 		"pluginID": plugin.ID,
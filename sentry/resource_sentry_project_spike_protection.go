@@ -0,0 +1,138 @@
+package sentry
+
+import (
+	"context"
+
+	"github.com/getkevin/terraform-provider-sentry/sentry/lib"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// spikeProtectionDisabledOption is the project option that records whether
+// spike protection is turned off for the project.
+const spikeProtectionDisabledOption = "quotas:spike-protection-disabled"
+
+func resourceSentryProjectSpikeProtection() *schema.Resource {
+	return &schema.Resource{
+		Description: "Sentry Project Spike Protection resource. This resource is used to create and manage spike protection for a project. " +
+			"Deleting this resource disables spike protection for the project.",
+
+		CreateContext: resourceSentryProjectSpikeProtectionUpdate,
+		ReadContext:   resourceSentryProjectSpikeProtectionRead,
+		UpdateContext: resourceSentryProjectSpikeProtectionUpdate,
+		DeleteContext: resourceSentryProjectSpikeProtectionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"organization": {
+				Description: "The slug of the organization the project belongs to.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"project": {
+				Description: "The slug of the project to manage spike protection for.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"enabled": {
+				Description: "Toggle spike protection on or off for the project.",
+				Type:        schema.TypeBool,
+				Required:    true,
+			},
+		},
+	}
+}
+
+func resourceSentryProjectSpikeProtectionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*sentry.Client)
+
+	org, project, err := splitSentryProjectSpikeProtectionID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	tflog.Debug(ctx, "Reading project spike protection", map[string]interface{}{
+		"org":     org,
+		"project": project,
+	})
+	proj, _, err := client.Projects.Get(ctx, org, project)
+	if found, err := checkClientGet(err, d); !found {
+		tflog.Info(ctx, "Removed project spike protection from state because the project no longer exists in Sentry", map[string]interface{}{
+			"org":     org,
+			"project": project,
+		})
+		return diagFromErr(err, d)
+	}
+
+	// Spike protection is enabled unless the project opted out.
+	disabled, _ := proj.Options[spikeProtectionDisabledOption].(bool)
+
+	retErr := multierror.Append(
+		d.Set("organization", org),
+		d.Set("project", proj.Slug),
+		d.Set("enabled", !disabled),
+	)
+	return diag.FromErr(retErr.ErrorOrNil())
+}
+
+func resourceSentryProjectSpikeProtectionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*sentry.Client)
+
+	org := d.Get("organization").(string)
+	project := d.Get("project").(string)
+	enabled := d.Get("enabled").(bool)
+
+	params := &sentry.SpikeProtectionParams{
+		Projects: []string{project},
+	}
+
+	tflog.Debug(ctx, "Updating project spike protection", map[string]interface{}{
+		"org":     org,
+		"project": project,
+		"enabled": enabled,
+	})
+	var err error
+	if enabled {
+		_, err = client.SpikeProtections.Enable(ctx, org, params)
+	} else {
+		_, err = client.SpikeProtections.Disable(ctx, org, params)
+	}
+	if err != nil {
+		return diagFromErr(err, d)
+	}
+
+	d.SetId(buildTwoPartID(org, project))
+	return resourceSentryProjectSpikeProtectionRead(ctx, d, meta)
+}
+
+func resourceSentryProjectSpikeProtectionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*sentry.Client)
+
+	org, project, err := splitSentryProjectSpikeProtectionID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	params := &sentry.SpikeProtectionParams{
+		Projects: []string{project},
+	}
+
+	tflog.Debug(ctx, "Deleting project spike protection", map[string]interface{}{
+		"org":     org,
+		"project": project,
+	})
+	_, err = client.SpikeProtections.Disable(ctx, org, params)
+	return diagFromErr(err, d)
+}
+
+func splitSentryProjectSpikeProtectionID(id string) (org string, project string, err error) {
+	org, project, err = splitTwoPartID(id, "organization-slug", "project-slug")
+	return
+}
@@ -0,0 +1,90 @@
+package sentry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/getkevin/terraform-provider-sentry/sentry/lib"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccSentryProjectSpikeProtection_basic(t *testing.T) {
+	teamName := acctest.RandomWithPrefix("tf-team")
+	projectName := acctest.RandomWithPrefix("tf-project")
+	rn := "sentry_project_spike_protection.test"
+
+	check := func(enabled bool) resource.TestCheckFunc {
+		return resource.ComposeTestCheckFunc(
+			testAccCheckSentryProjectSpikeProtectionEnabled(rn, enabled),
+			resource.TestCheckResourceAttrPair(rn, "organization", "sentry_project.test", "organization"),
+			resource.TestCheckResourceAttrPair(rn, "project", "sentry_project.test", "id"),
+			resource.TestCheckResourceAttr(rn, "enabled", fmt.Sprintf("%t", enabled)),
+		)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckSentryProjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSentryProjectSpikeProtectionConfig(teamName, projectName, false),
+				Check:  check(false),
+			},
+			{
+				Config: testAccSentryProjectSpikeProtectionConfig(teamName, projectName, true),
+				Check:  check(true),
+			},
+			{
+				ResourceName:      rn,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckSentryProjectSpikeProtectionEnabled(n string, enabled bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("no ID is set")
+		}
+
+		org, project, err := splitSentryProjectSpikeProtectionID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*sentry.Client)
+		ctx := context.Background()
+		proj, _, err := client.Projects.Get(ctx, org, project)
+		if err != nil {
+			return err
+		}
+
+		disabled, _ := proj.Options[spikeProtectionDisabledOption].(bool)
+		if disabled == enabled {
+			return fmt.Errorf("expected spike protection enabled to be %t", enabled)
+		}
+		return nil
+	}
+}
+
+func testAccSentryProjectSpikeProtectionConfig(teamName, projectName string, enabled bool) string {
+	return testAccSentryProjectConfig_team(teamName, projectName) + fmt.Sprintf(`
+resource "sentry_project_spike_protection" "test" {
+	organization = sentry_project.test.organization
+	project      = sentry_project.test.id
+	enabled      = %[1]t
+}
+	`, enabled)
+}
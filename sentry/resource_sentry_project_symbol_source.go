@@ -0,0 +1,369 @@
+package sentry
+
+import (
+	"context"
+
+	"github.com/getkevin/terraform-provider-sentry/sentry/lib"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceSentryProjectSymbolSource() *schema.Resource {
+	return &schema.Resource{
+		Description: "Sentry Project Symbol Source. See the " +
+			"[Sentry documentation](https://docs.sentry.io/api/projects/add-a-symbol-source-to-a-project/) " +
+			"for more information.",
+
+		CreateContext: resourceSentryProjectSymbolSourceCreate,
+		ReadContext:   resourceSentryProjectSymbolSourceRead,
+		UpdateContext: resourceSentryProjectSymbolSourceUpdate,
+		DeleteContext: resourceSentryProjectSymbolSourceDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"organization": {
+				Description: "The slug of the organization the project belongs to.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"project": {
+				Description: "The slug of the project to add the symbol source to.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"type": {
+				Description:  "The type of symbol source. One of `http` (SymbolServer (HTTP)), `gcs` (Google Cloud Storage), `s3` (Amazon S3).",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"http", "gcs", "s3"}, false),
+			},
+			"name": {
+				Description: "The human-readable name of the source.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"layout": {
+				Description: "Layout settings for the source.",
+				Type:        schema.TypeList,
+				Required:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Description: "The layout of the folder structure. The options are: " +
+								"`native` - Platform-Specific (SymStore / GDB / LLVM), " +
+								"`symstore` - Microsoft SymStore, " +
+								"`symstore_index2` - Microsoft SymStore (with index2.txt), " +
+								"`ssqp` - Microsoft SSQP, " +
+								"`unified` - Unified Symbol Server Layout, " +
+								"`debuginfod` - debuginfod.",
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"native", "symstore", "symstore_index2", "ssqp", "unified", "debuginfod"}, false),
+						},
+						"casing": {
+							Description: "The casing of the symbol source layout. The options are: " +
+								"`default` - Default (mixed case), `uppercase` - Uppercase, `lowercase` - Lowercase.",
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"default", "uppercase", "lowercase"}, false),
+						},
+					},
+				},
+			},
+			"filters": {
+				Description: "Restricts which debug files are looked up in the source. Sentry fills in defaults for omitted filters.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"filetypes": {
+							Description: "The debug file types to look up, e.g. `pe`, `pdb`, `macho`, `elf_debug`.",
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Computed:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"path_patterns": {
+							Description: "Glob patterns the debug file paths must match.",
+							Type:        schema.TypeList,
+							Optional:    true,
+							Computed:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"requires_checksum": {
+							Description: "Whether only debug files with a checksum are looked up.",
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"url": {
+				Description: "The source's URL. Required for HTTP sources, invalid for all others.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"username": {
+				Description: "The user name for accessing the source. Optional for HTTP sources, invalid for all others.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"password": {
+				Description: "The password for accessing the source. Optional for HTTP sources, invalid for all others.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"bucket": {
+				Description: "The GCS or S3 bucket where the source resides. Required for GCS and S3 sources, invalid for HTTP sources.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"prefix": {
+				Description: "The GCS or S3 prefix. Optional for GCS and S3 sources, invalid for HTTP sources.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"client_email": {
+				Description: "The GCS email address for authentication. Required for GCS sources, invalid for all others.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"private_key": {
+				Description: "The GCS private key. Required for GCS sources, invalid for all others.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"region": {
+				Description: "The source's S3 region. Required for S3 sources, invalid for all others.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"access_key": {
+				Description: "The AWS Access Key. Required for S3 sources, invalid for all others.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"secret_key": {
+				Description: "The AWS Secret Access Key. Required for S3 sources, invalid for all others.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+func resourceSentryProjectSymbolSourceParams(d *schema.ResourceData) *sentry.ProjectSymbolSourceParams {
+	params := &sentry.ProjectSymbolSourceParams{
+		Type:    sentry.String(d.Get("type").(string)),
+		Name:    sentry.String(d.Get("name").(string)),
+		Layout:  expandProjectSymbolSourceLayout(d.Get("layout").([]interface{})),
+		Filters: expandProjectSymbolSourceFilters(d.Get("filters").([]interface{})),
+	}
+
+	optionalStrings := map[string]**string{
+		"url":          &params.URL,
+		"username":     &params.Username,
+		"password":     &params.Password,
+		"bucket":       &params.Bucket,
+		"prefix":       &params.Prefix,
+		"client_email": &params.ClientEmail,
+		"private_key":  &params.PrivateKey,
+		"region":       &params.Region,
+		"access_key":   &params.AccessKey,
+		"secret_key":   &params.SecretKey,
+	}
+	for k, field := range optionalStrings {
+		if v, ok := d.GetOk(k); ok {
+			*field = sentry.String(v.(string))
+		}
+	}
+
+	return params
+}
+
+func resourceSentryProjectSymbolSourceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*sentry.Client)
+
+	org := d.Get("organization").(string)
+	project := d.Get("project").(string)
+	params := resourceSentryProjectSymbolSourceParams(d)
+
+	tflog.Debug(ctx, "Creating project symbol source", map[string]interface{}{
+		"org":     org,
+		"project": project,
+		"type":    *params.Type,
+	})
+	source, _, err := client.ProjectSymbolSources.Create(ctx, org, project, params)
+	if err != nil {
+		return diagFromErr(err, d)
+	}
+
+	d.SetId(buildThreePartID(org, project, sentry.StringValue(source.ID)))
+	return resourceSentryProjectSymbolSourceRead(ctx, d, meta)
+}
+
+func resourceSentryProjectSymbolSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*sentry.Client)
+
+	org, project, sourceID, err := splitSentryProjectSymbolSourceID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	tflog.Debug(ctx, "Reading project symbol source", map[string]interface{}{
+		"org":      org,
+		"project":  project,
+		"sourceID": sourceID,
+	})
+	source, _, err := client.ProjectSymbolSources.Get(ctx, org, project, sourceID)
+	if found, err := checkClientGet(err, d); !found {
+		tflog.Info(ctx, "Removed project symbol source from state because it no longer exists in Sentry", map[string]interface{}{
+			"org":      org,
+			"project":  project,
+			"sourceID": sourceID,
+		})
+		return diag.FromErr(err)
+	}
+
+	// Secrets are never returned by the API, so password, private_key and
+	// secret_key are left as configured.
+	retErr := multierror.Append(
+		d.Set("organization", org),
+		d.Set("project", project),
+		d.Set("type", source.Type),
+		d.Set("name", source.Name),
+		d.Set("layout", flattenProjectSymbolSourceLayout(source.Layout)),
+		d.Set("filters", flattenProjectSymbolSourceFilters(source.Filters)),
+		d.Set("url", source.URL),
+		d.Set("username", source.Username),
+		d.Set("bucket", source.Bucket),
+		d.Set("prefix", source.Prefix),
+		d.Set("client_email", source.ClientEmail),
+		d.Set("region", source.Region),
+		d.Set("access_key", source.AccessKey),
+	)
+	return diag.FromErr(retErr.ErrorOrNil())
+}
+
+func resourceSentryProjectSymbolSourceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*sentry.Client)
+
+	org, project, sourceID, err := splitSentryProjectSymbolSourceID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	params := resourceSentryProjectSymbolSourceParams(d)
+	params.ID = sentry.String(sourceID)
+
+	tflog.Debug(ctx, "Updating project symbol source", map[string]interface{}{
+		"org":      org,
+		"project":  project,
+		"sourceID": sourceID,
+	})
+	_, _, err = client.ProjectSymbolSources.Update(ctx, org, project, sourceID, params)
+	if err != nil {
+		return diagFromErr(err, d)
+	}
+
+	return resourceSentryProjectSymbolSourceRead(ctx, d, meta)
+}
+
+func resourceSentryProjectSymbolSourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*sentry.Client)
+
+	org, project, sourceID, err := splitSentryProjectSymbolSourceID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	tflog.Debug(ctx, "Deleting project symbol source", map[string]interface{}{
+		"org":      org,
+		"project":  project,
+		"sourceID": sourceID,
+	})
+	_, err = client.ProjectSymbolSources.Delete(ctx, org, project, sourceID)
+	return diag.FromErr(err)
+}
+
+func expandProjectSymbolSourceLayout(l []interface{}) *sentry.ProjectSymbolSourceLayout {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+	return &sentry.ProjectSymbolSourceLayout{
+		Type:   sentry.String(m["type"].(string)),
+		Casing: sentry.String(m["casing"].(string)),
+	}
+}
+
+func flattenProjectSymbolSourceLayout(layout *sentry.ProjectSymbolSourceLayout) []interface{} {
+	if layout == nil {
+		return nil
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"type":   sentry.StringValue(layout.Type),
+			"casing": sentry.StringValue(layout.Casing),
+		},
+	}
+}
+
+func expandProjectSymbolSourceFilters(l []interface{}) *sentry.ProjectSymbolSourceFilters {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+	filters := &sentry.ProjectSymbolSourceFilters{
+		RequiresChecksum: sentry.Bool(m["requires_checksum"].(bool)),
+	}
+	if v := m["filetypes"].(*schema.Set).List(); len(v) > 0 {
+		filters.FileTypes = expandStringList(v)
+	}
+	if v := m["path_patterns"].([]interface{}); len(v) > 0 {
+		filters.PathPatterns = expandStringList(v)
+	}
+	return filters
+}
+
+func flattenProjectSymbolSourceFilters(filters *sentry.ProjectSymbolSourceFilters) []interface{} {
+	if filters == nil {
+		return nil
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"filetypes":         flattenStringSet(filters.FileTypes),
+			"path_patterns":     filters.PathPatterns,
+			"requires_checksum": sentry.BoolValue(filters.RequiresChecksum),
+		},
+	}
+}
+
+func splitSentryProjectSymbolSourceID(id string) (org string, project string, sourceID string, err error) {
+	org, project, sourceID, err = splitThreePartID(id, "organization-slug", "project-slug", "symbol-source-id")
+	return
+}
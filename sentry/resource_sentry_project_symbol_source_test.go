@@ -0,0 +1,183 @@
+package sentry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/getkevin/terraform-provider-sentry/sentry/lib"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccSentryProjectSymbolSource_http(t *testing.T) {
+	teamName := acctest.RandomWithPrefix("tf-team")
+	projectName := acctest.RandomWithPrefix("tf-project")
+	rn := "sentry_project_symbol_source.test"
+
+	check := func(name string) resource.TestCheckFunc {
+		return resource.ComposeTestCheckFunc(
+			testAccCheckSentryProjectSymbolSourceExists(rn),
+			resource.TestCheckResourceAttrPair(rn, "organization", "sentry_project.test", "organization"),
+			resource.TestCheckResourceAttrPair(rn, "project", "sentry_project.test", "id"),
+			resource.TestCheckResourceAttr(rn, "type", "http"),
+			resource.TestCheckResourceAttr(rn, "name", name),
+			resource.TestCheckResourceAttr(rn, "layout.0.type", "native"),
+			resource.TestCheckResourceAttr(rn, "layout.0.casing", "default"),
+			resource.TestCheckResourceAttr(rn, "url", "https://example.com"),
+			resource.TestCheckResourceAttr(rn, "username", "admin"),
+			resource.TestCheckResourceAttr(rn, "password", "password"),
+		)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckSentryProjectSymbolSourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSentryProjectSymbolSourceConfig_http(teamName, projectName, "SymbolServer"),
+				Check:  check("SymbolServer"),
+			},
+			{
+				Config: testAccSentryProjectSymbolSourceConfig_http(teamName, projectName, "SymbolServer (HTTP)"),
+				Check:  check("SymbolServer (HTTP)"),
+			},
+			{
+				ResourceName:            rn,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+		},
+	})
+}
+
+func TestAccSentryProjectSymbolSource_s3(t *testing.T) {
+	teamName := acctest.RandomWithPrefix("tf-team")
+	projectName := acctest.RandomWithPrefix("tf-project")
+	rn := "sentry_project_symbol_source.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckSentryProjectSymbolSourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSentryProjectConfig_team(teamName, projectName) + `
+resource "sentry_project_symbol_source" "test" {
+	organization = sentry_project.test.organization
+	project      = sentry_project.test.id
+	type         = "s3"
+	name         = "Amazon S3"
+
+	layout {
+		type   = "native"
+		casing = "lowercase"
+	}
+
+	filters {
+		filetypes         = ["pe", "pdb"]
+		path_patterns     = ["*.dll"]
+		requires_checksum = true
+	}
+
+	bucket     = "s3-bucket-name"
+	prefix     = "symbols/"
+	region     = "us-east-1"
+	access_key = "access_key"
+	secret_key = "secret_key"
+}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSentryProjectSymbolSourceExists(rn),
+					resource.TestCheckResourceAttr(rn, "type", "s3"),
+					resource.TestCheckResourceAttr(rn, "filters.0.filetypes.#", "2"),
+					resource.TestCheckResourceAttr(rn, "filters.0.path_patterns.0", "*.dll"),
+					resource.TestCheckResourceAttr(rn, "filters.0.requires_checksum", "true"),
+					resource.TestCheckResourceAttr(rn, "bucket", "s3-bucket-name"),
+					resource.TestCheckResourceAttr(rn, "region", "us-east-1"),
+					resource.TestCheckResourceAttr(rn, "access_key", "access_key"),
+					resource.TestCheckResourceAttr(rn, "secret_key", "secret_key"),
+				),
+			},
+			{
+				ResourceName:            rn,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"secret_key"},
+			},
+		},
+	})
+}
+
+func testAccCheckSentryProjectSymbolSourceDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*sentry.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "sentry_project_symbol_source" {
+			continue
+		}
+
+		org, project, sourceID, err := splitSentryProjectSymbolSourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		ctx := context.Background()
+		_, resp, err := client.ProjectSymbolSources.Get(ctx, org, project, sourceID)
+		if err == nil {
+			return errors.New("project symbol source still exists")
+		}
+		if resp.StatusCode != 404 {
+			return err
+		}
+		return nil
+	}
+	return nil
+}
+
+func testAccCheckSentryProjectSymbolSourceExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("no ID is set")
+		}
+
+		org, project, sourceID, err := splitSentryProjectSymbolSourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*sentry.Client)
+		ctx := context.Background()
+		_, _, err = client.ProjectSymbolSources.Get(ctx, org, project, sourceID)
+		return err
+	}
+}
+
+func testAccSentryProjectSymbolSourceConfig_http(teamName, projectName, name string) string {
+	return testAccSentryProjectConfig_team(teamName, projectName) + fmt.Sprintf(`
+resource "sentry_project_symbol_source" "test" {
+	organization = sentry_project.test.organization
+	project      = sentry_project.test.id
+	type         = "http"
+	name         = %[1]q
+
+	layout {
+		type   = "native"
+		casing = "default"
+	}
+
+	url      = "https://example.com"
+	username = "admin"
+	password = "password"
+}
+	`, name)
+}
@@ -2,8 +2,10 @@ package sentry
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"reflect"
 	"regexp"
 	"strconv"
 	"strings"
@@ -187,6 +189,55 @@ func TestAccSentryProject_noTeam(t *testing.T) {
 	})
 }
 
+func TestAccSentryProject_timeouts(t *testing.T) {
+	teamName := acctest.RandomWithPrefix("tf-team")
+	projectName := acctest.RandomWithPrefix("tf-project")
+	rn := "sentry_project.test"
+
+	var projectID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckSentryProjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSentryTeamConfig(teamName) + fmt.Sprintf(`
+resource "sentry_project" "test" {
+	organization = sentry_team.test.organization
+	team         = sentry_team.test.slug
+	name         = "%[1]s"
+	platform     = "go"
+
+	timeouts {
+		create = "5m"
+		read   = "1m"
+		update = "5m"
+		delete = "30m"
+	}
+}
+				`, projectName),
+				Check: testAccCheckSentryProjectExists(rn, &projectID),
+			},
+			{
+				Config: testAccSentryTeamConfig(teamName) + fmt.Sprintf(`
+resource "sentry_project" "test" {
+	organization = sentry_team.test.organization
+	team         = sentry_team.test.slug
+	name         = "%[1]s"
+	platform     = "go"
+
+	timeouts {
+		unknown = "5m"
+	}
+}
+				`, projectName),
+				ExpectError: regexp.MustCompile(`An argument named "unknown" is not expected here`),
+			},
+		},
+	})
+}
+
 func TestAccSentryProject_teamConflict(t *testing.T) {
 	teamName := acctest.RandomWithPrefix("tf-team")
 	projectName := acctest.RandomWithPrefix("tf-project")
@@ -247,6 +298,103 @@ func TestAccSentryProject_changeTeam(t *testing.T) {
 	})
 }
 
+func TestAccSentryProject_settings(t *testing.T) {
+	teamName := acctest.RandomWithPrefix("tf-team")
+	projectName := acctest.RandomWithPrefix("tf-project")
+	rn := "sentry_project.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckSentryProjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSentryProjectConfig_settings(teamName, projectName, "[tf]", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rn, "subject_prefix", "[tf]"),
+					resource.TestCheckResourceAttr(rn, "scrub_ip_addresses", "true"),
+					resource.TestCheckResourceAttr(rn, "allowed_domains.#", "1"),
+					resource.TestCheckTypeSetElemAttr(rn, "allowed_domains.*", "example.com"),
+					resource.TestCheckResourceAttr(rn, "sensitive_fields.#", "1"),
+					resource.TestCheckResourceAttr(rn, "sensitive_fields.0", "api_key"),
+					resource.TestCheckResourceAttrWith(rn, "fingerprinting_rules", func(v string) error {
+						if want := "error.type:DatabaseUnavailable -> system-down"; strings.TrimSpace(v) != want {
+							return fmt.Errorf("got fingerprinting rules %q; want %q", v, want)
+						}
+						return nil
+					}),
+					resource.TestCheckResourceAttr(rn, "options.%", "1"),
+					resource.TestCheckResourceAttr(rn, "options.sentry:token_header", "X-Sentry-Token"),
+					resource.TestCheckResourceAttrSet(rn, "verify_ssl"),
+				),
+			},
+			{
+				Config: testAccSentryProjectConfig_settings(teamName, projectName, "[tf-renamed]", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rn, "subject_prefix", "[tf-renamed]"),
+					resource.TestCheckResourceAttr(rn, "scrub_ip_addresses", "false"),
+				),
+			},
+			{
+				ResourceName:            rn,
+				ImportState:             true,
+				ImportStateIdFunc:       testAccSentryProjectImportStateIdFunc(rn),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"options"},
+			},
+		},
+	})
+}
+
+func TestExpandProjectOptions(t *testing.T) {
+	got := expandProjectOptions(map[string]interface{}{
+		"sentry:token_header":     "X-Sentry-Token",
+		"sentry:resolve_age":      "720",
+		"sentry:scrub_data":       "false",
+		"sentry:reprocessing":     "[1, 2]",
+		"sentry:grouping_config":  "newstyle:2023-01-11",
+		"sentry:csp_ignored_urls": "",
+	})
+	want := map[string]interface{}{
+		"sentry:token_header":     "X-Sentry-Token",
+		"sentry:resolve_age":      float64(720),
+		"sentry:scrub_data":       false,
+		"sentry:reprocessing":     []interface{}{float64(1), float64(2)},
+		"sentry:grouping_config":  "newstyle:2023-01-11",
+		"sentry:csp_ignored_urls": "",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expandProjectOptions() = %#v, want %#v", got, want)
+	}
+}
+
+func TestFlattenProjectOptions(t *testing.T) {
+	configured := map[string]interface{}{
+		"sentry:token_header": "",
+		"sentry:resolve_age":  "",
+		"sentry:scrub_data":   "",
+		"sentry:reprocessing": "",
+		"sentry:missing":      "",
+	}
+	options := map[string]interface{}{
+		"sentry:token_header": "X-Sentry-Token",
+		"sentry:resolve_age":  json.Number("720"),
+		"sentry:scrub_data":   false,
+		"sentry:reprocessing": []interface{}{json.Number("1")},
+		"sentry:unmanaged":    "ignored",
+	}
+	got := flattenProjectOptions(configured, options)
+	want := map[string]string{
+		"sentry:token_header": "X-Sentry-Token",
+		"sentry:resolve_age":  "720",
+		"sentry:scrub_data":   "false",
+		"sentry:reprocessing": "[1]",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("flattenProjectOptions() = %#v, want %#v", got, want)
+	}
+}
+
 func testAccCheckSentryProjectDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*sentry.Client)
 
@@ -417,3 +565,27 @@ resource "sentry_project" "test" {
 
 	return config
 }
+
+func testAccSentryProjectConfig_settings(teamName, projectName, subjectPrefix string, scrubIPAddresses bool) string {
+	return testAccSentryTeamConfig(teamName) + fmt.Sprintf(`
+resource "sentry_project" "test" {
+	organization = sentry_team.test.organization
+	teams        = [sentry_team.test.slug]
+	name         = "%[1]s"
+	platform     = "go"
+
+	subject_prefix     = "%[2]s"
+	scrub_ip_addresses = %[3]t
+	allowed_domains    = ["example.com"]
+	sensitive_fields   = ["api_key"]
+
+	fingerprinting_rules = <<EOT
+error.type:DatabaseUnavailable -> system-down
+EOT
+
+	options = {
+		"sentry:token_header" = "X-Sentry-Token"
+	}
+}
+	`, projectName, subjectPrefix, scrubIPAddresses)
+}
@@ -0,0 +1,291 @@
+package sentry
+
+import (
+	"context"
+
+	"github.com/getkevin/terraform-provider-sentry/sentry/lib"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceSentryRelease() *schema.Resource {
+	return &schema.Resource{
+		Description: "Sentry Release resource. Creates a release, associates commits with it and finalizes it.",
+
+		CreateContext: resourceSentryReleaseCreate,
+		ReadContext:   resourceSentryReleaseRead,
+		UpdateContext: resourceSentryReleaseUpdate,
+		DeleteContext: resourceSentryReleaseDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"organization": {
+				Description: "The slug of the organization the release belongs to.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"version": {
+				Description: "The version identifier of the release.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"projects": {
+				Description: "The slugs of the projects the release belongs to. Sentry cannot remove projects from a " +
+					"release, and projects added outside of Terraform, e.g. by `sentry-cli`, are ignored.",
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"ref": {
+				Description: "An optional commit reference. This is useful if a tagged version has been provided.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			"url": {
+				Description: "A URL that points to the release. This can be the path to an online interface to the source code for instance.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			"date_released": {
+				Description: "An optional RFC 3339 timestamp of when the release went live. " +
+					"Setting it finalizes the release. Once set, it cannot be removed again.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validation.IsRFC3339Time,
+				DiffSuppressFunc: suppressEquivalentTimeDiffs,
+			},
+			"refs": {
+				Description: "Commits to associate with the release, in the same way as `sentry-cli releases set-commits`. " +
+					"Sentry fetches the commits between `previous_commit` and `commit` from the repository integration. " +
+					"These are only sent to Sentry and are not read back.",
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"repository": {
+							Description: "The full name of the repository the commit belongs to, e.g. `getsentry/sentry`.",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+						"commit": {
+							Description: "The SHA of the current commit.",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+						"previous_commit": {
+							Description: "The SHA of the commit of the previous release.",
+							Type:        schema.TypeString,
+							Optional:    true,
+						},
+					},
+				},
+			},
+			"short_version": {
+				Description: "The short version of the release.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"date_created": {
+				Description: "The RFC 3339 timestamp of when the release was created.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"commit_count": {
+				Description: "The number of commits associated with the release.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"deploy_count": {
+				Description: "The number of deploys of the release.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"new_groups": {
+				Description: "The number of new issues first seen in the release.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"internal_id": {
+				Description: "The internal ID for this release.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceSentryReleaseCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*sentry.Client)
+
+	org := d.Get("organization").(string)
+	params := &sentry.CreateReleaseParams{
+		Version:  d.Get("version").(string),
+		Projects: expandStringList(d.Get("projects").(*schema.Set).List()),
+		Refs:     expandReleaseRefs(d.Get("refs").([]interface{})),
+	}
+	if v, ok := d.GetOk("ref"); ok {
+		params.Ref = sentry.String(v.(string))
+	}
+	if v, ok := d.GetOk("url"); ok {
+		params.URL = sentry.String(v.(string))
+	}
+
+	var err error
+	if params.DateReleased, err = expandTime(d.Get("date_released").(string)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	tflog.Debug(ctx, "Creating release", map[string]interface{}{
+		"org":     org,
+		"version": params.Version,
+	})
+	release, _, err := client.Releases.Create(ctx, org, params)
+	if err != nil {
+		return diagFromErr(err, d)
+	}
+
+	d.SetId(buildTwoPartID(org, release.Version))
+	return resourceSentryReleaseRead(ctx, d, meta)
+}
+
+func resourceSentryReleaseRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*sentry.Client)
+
+	org, version, err := splitSentryReleaseID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	tflog.Debug(ctx, "Reading release", map[string]interface{}{
+		"org":     org,
+		"version": version,
+	})
+	release, _, err := client.Releases.Get(ctx, org, version)
+	if found, err := checkClientGet(err, d); !found {
+		tflog.Info(ctx, "Removed release from state because it no longer exists in Sentry", map[string]interface{}{
+			"org":     org,
+			"version": version,
+		})
+		return diag.FromErr(err)
+	}
+
+	// Only keep the managed projects, since other tools such as sentry-cli
+	// add projects to releases as well. All projects are read on import.
+	managed := d.Get("projects").(*schema.Set)
+	projects := make([]string, 0, len(release.Projects))
+	for _, project := range release.Projects {
+		if managed.Len() == 0 || managed.Contains(project.Slug) {
+			projects = append(projects, project.Slug)
+		}
+	}
+
+	retErr := multierror.Append(
+		d.Set("organization", org),
+		d.Set("version", release.Version),
+		d.Set("projects", flattenStringSet(projects)),
+		d.Set("ref", release.Ref),
+		d.Set("url", release.URL),
+		d.Set("date_released", flattenTime(release.DateReleased)),
+		d.Set("short_version", release.ShortVersion),
+		d.Set("date_created", flattenTime(&release.DateCreated)),
+		d.Set("commit_count", release.CommitCount),
+		d.Set("deploy_count", release.DeployCount),
+		d.Set("new_groups", release.NewGroups),
+		d.Set("internal_id", release.ID),
+	)
+	return diag.FromErr(retErr.ErrorOrNil())
+}
+
+func resourceSentryReleaseUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*sentry.Client)
+
+	org, version, err := splitSentryReleaseID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	params := &sentry.UpdateReleaseParams{}
+	if d.HasChange("projects") {
+		params.Projects = expandStringList(d.Get("projects").(*schema.Set).List())
+	}
+	if d.HasChange("ref") {
+		params.Ref = sentry.String(d.Get("ref").(string))
+	}
+	if d.HasChange("url") {
+		params.URL = sentry.String(d.Get("url").(string))
+	}
+	if d.HasChange("date_released") {
+		if params.DateReleased, err = expandTime(d.Get("date_released").(string)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if d.HasChange("refs") {
+		params.Refs = expandReleaseRefs(d.Get("refs").([]interface{}))
+	}
+
+	tflog.Debug(ctx, "Updating release", map[string]interface{}{
+		"org":     org,
+		"version": version,
+	})
+	_, _, err = client.Releases.Update(ctx, org, version, params)
+	if err != nil {
+		return diagFromErr(err, d)
+	}
+
+	return resourceSentryReleaseRead(ctx, d, meta)
+}
+
+func resourceSentryReleaseDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*sentry.Client)
+
+	org, version, err := splitSentryReleaseID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	tflog.Debug(ctx, "Deleting release", map[string]interface{}{
+		"org":     org,
+		"version": version,
+	})
+	_, err = client.Releases.Delete(ctx, org, version)
+	return diag.FromErr(err)
+}
+
+func expandReleaseRefs(refs []interface{}) []sentry.ReleaseRef {
+	if len(refs) == 0 {
+		return nil
+	}
+
+	out := make([]sentry.ReleaseRef, 0, len(refs))
+	for _, ref := range refs {
+		m := ref.(map[string]interface{})
+		r := sentry.ReleaseRef{
+			Repository: m["repository"].(string),
+			Commit:     m["commit"].(string),
+		}
+		if v := m["previous_commit"].(string); v != "" {
+			r.PreviousCommit = sentry.String(v)
+		}
+		out = append(out, r)
+	}
+	return out
+}
+
+func splitSentryReleaseID(id string) (org string, version string, err error) {
+	org, version, err = splitTwoPartID(id, "organization-slug", "version")
+	return
+}
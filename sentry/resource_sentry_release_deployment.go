@@ -0,0 +1,194 @@
+package sentry
+
+import (
+	"context"
+
+	"github.com/getkevin/terraform-provider-sentry/sentry/lib"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// resourceSentryReleaseDeployment has no update, since deploys cannot be
+// modified once created. Every attribute forces a new deploy instead.
+func resourceSentryReleaseDeployment() *schema.Resource {
+	return &schema.Resource{
+		Description: "Sentry Release Deployment resource. Records a deploy of a release to an environment. " +
+			"Sentry does not support deleting deploys, so destroying this resource only removes it from the Terraform state.",
+
+		CreateContext: resourceSentryReleaseDeploymentCreate,
+		ReadContext:   resourceSentryReleaseDeploymentRead,
+		DeleteContext: resourceSentryReleaseDeploymentDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"organization": {
+				Description: "The slug of the organization the release belongs to.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"version": {
+				Description: "The version of the release that was deployed.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"environment": {
+				Description: "The environment the release was deployed to.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"name": {
+				Description: "The optional name of the deploy.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+			"url": {
+				Description: "The optional URL that points to the deploy.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+			"date_started": {
+				Description:      "An optional RFC 3339 timestamp of when the deploy started.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				ValidateFunc:     validation.IsRFC3339Time,
+				DiffSuppressFunc: suppressEquivalentTimeDiffs,
+			},
+			"date_finished": {
+				Description:      "An optional RFC 3339 timestamp of when the deploy finished. Defaults to the time the deploy is created.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				ValidateFunc:     validation.IsRFC3339Time,
+				DiffSuppressFunc: suppressEquivalentTimeDiffs,
+			},
+			"projects": {
+				Description: "The optional list of project slugs the deploy applies to. Defaults to all projects of the release. Sentry does not return the projects of a deploy, so they are not read back or imported.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				ForceNew:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"internal_id": {
+				Description: "The internal ID for this deploy.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceSentryReleaseDeploymentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*sentry.Client)
+
+	org := d.Get("organization").(string)
+	version := d.Get("version").(string)
+
+	params := &sentry.ReleaseDeployment{
+		Environment: d.Get("environment").(string),
+	}
+	if v, ok := d.GetOk("name"); ok {
+		params.Name = sentry.String(v.(string))
+	}
+	if v, ok := d.GetOk("url"); ok {
+		params.URL = sentry.String(v.(string))
+	}
+	if v, ok := d.GetOk("projects"); ok {
+		params.Projects = expandStringList(v.(*schema.Set).List())
+	}
+
+	var err error
+	if params.DateStarted, err = expandTime(d.Get("date_started").(string)); err != nil {
+		return diag.FromErr(err)
+	}
+	if params.DateFinished, err = expandTime(d.Get("date_finished").(string)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	tflog.Debug(ctx, "Creating release deployment", map[string]interface{}{
+		"org":         org,
+		"version":     version,
+		"environment": params.Environment,
+	})
+	deploy, _, err := client.ReleaseDeployments.Create(ctx, org, version, params)
+	if err != nil {
+		return diagFromErr(err, d)
+	}
+
+	d.SetId(buildThreePartID(org, version, deploy.ID))
+	return resourceSentryReleaseDeploymentRead(ctx, d, meta)
+}
+
+func resourceSentryReleaseDeploymentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*sentry.Client)
+
+	org, version, deployID, err := splitSentryReleaseDeploymentID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	tflog.Debug(ctx, "Reading release deployment", map[string]interface{}{
+		"org":      org,
+		"version":  version,
+		"deployID": deployID,
+	})
+	deploy, _, err := client.ReleaseDeployments.Get(ctx, org, version, deployID)
+	if found, err := checkClientGet(err, d); !found {
+		tflog.Info(ctx, "Removed release deployment from state because the release no longer exists in Sentry", map[string]interface{}{
+			"org":     org,
+			"version": version,
+		})
+		return diag.FromErr(err)
+	}
+	if deploy == nil {
+		tflog.Info(ctx, "Removed release deployment from state because it no longer exists in Sentry", map[string]interface{}{
+			"org":      org,
+			"version":  version,
+			"deployID": deployID,
+		})
+		d.SetId("")
+		return nil
+	}
+
+	retErr := multierror.Append(
+		d.Set("organization", org),
+		d.Set("version", version),
+		d.Set("environment", deploy.Environment),
+		d.Set("name", deploy.Name),
+		d.Set("url", deploy.URL),
+		d.Set("date_started", flattenTime(deploy.DateStarted)),
+		d.Set("date_finished", flattenTime(deploy.DateFinished)),
+		d.Set("internal_id", deploy.ID),
+	)
+	return diag.FromErr(retErr.ErrorOrNil())
+}
+
+func resourceSentryReleaseDeploymentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "Removing release deployment from state only, Sentry does not support deleting deploys", map[string]interface{}{
+		"id": d.Id(),
+	})
+	d.SetId("")
+	return nil
+}
+
+func splitSentryReleaseDeploymentID(id string) (org string, version string, deployID string, err error) {
+	org, version, deployID, err = splitThreePartID(id, "organization-slug", "version", "deploy-id")
+	return
+}
@@ -0,0 +1,88 @@
+package sentry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/getkevin/terraform-provider-sentry/sentry/lib"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccSentryReleaseDeployment_basic(t *testing.T) {
+	teamName := acctest.RandomWithPrefix("tf-team")
+	projectName := acctest.RandomWithPrefix("tf-project")
+	version := acctest.RandomWithPrefix("tf-release")
+	rn := "sentry_release_deployment.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckSentryReleaseDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSentryReleaseDeploymentConfig(teamName, projectName, version),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSentryReleaseDeploymentExists(rn),
+					resource.TestCheckResourceAttrPair(rn, "version", "sentry_release.test", "version"),
+					resource.TestCheckResourceAttr(rn, "environment", "production"),
+					resource.TestCheckResourceAttr(rn, "name", "Deploy 1"),
+					resource.TestCheckResourceAttr(rn, "projects.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(rn, "projects.*", "sentry_project.test", "id"),
+					resource.TestCheckResourceAttrSet(rn, "date_finished"),
+					resource.TestCheckResourceAttrSet(rn, "internal_id"),
+				),
+			},
+			{
+				ResourceName:            rn,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"projects"},
+			},
+		},
+	})
+}
+
+func testAccCheckSentryReleaseDeploymentExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("no ID is set")
+		}
+
+		org, version, deployID, err := splitSentryReleaseDeploymentID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*sentry.Client)
+		ctx := context.Background()
+		deploy, _, err := client.ReleaseDeployments.Get(ctx, org, version, deployID)
+		if err != nil {
+			return err
+		}
+		if deploy == nil {
+			return fmt.Errorf("not found: %s", n)
+		}
+		return nil
+	}
+}
+
+func testAccSentryReleaseDeploymentConfig(teamName, projectName, version string) string {
+	return testAccSentryReleaseConfig(teamName, projectName, version, "") + `
+resource "sentry_release_deployment" "test" {
+	organization = sentry_release.test.organization
+	version      = sentry_release.test.version
+	environment  = "production"
+	name         = "Deploy 1"
+	projects     = [sentry_project.test.id]
+}
+	`
+}
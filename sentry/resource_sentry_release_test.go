@@ -0,0 +1,183 @@
+package sentry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/getkevin/terraform-provider-sentry/sentry/lib"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccSentryRelease_basic(t *testing.T) {
+	teamName := acctest.RandomWithPrefix("tf-team")
+	projectName := acctest.RandomWithPrefix("tf-project")
+	version := acctest.RandomWithPrefix("tf-release")
+	rn := "sentry_release.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckSentryReleaseDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSentryReleaseConfig(teamName, projectName, version, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSentryReleaseExists(rn),
+					resource.TestCheckResourceAttrPair(rn, "organization", "sentry_project.test", "organization"),
+					resource.TestCheckResourceAttr(rn, "version", version),
+					resource.TestCheckResourceAttr(rn, "projects.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(rn, "projects.*", "sentry_project.test", "id"),
+					resource.TestCheckResourceAttr(rn, "date_released", ""),
+					resource.TestCheckResourceAttrSet(rn, "date_created"),
+					resource.TestCheckResourceAttrSet(rn, "internal_id"),
+				),
+			},
+			{
+				Config: testAccSentryReleaseConfig(teamName, projectName, version, "2022-06-01T10:00:00Z"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSentryReleaseExists(rn),
+					resource.TestCheckResourceAttr(rn, "url", "https://example.com/releases/"+version),
+					resource.TestCheckResourceAttrSet(rn, "date_released"),
+				),
+			},
+			{
+				ResourceName:      rn,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSentryRelease_addProject(t *testing.T) {
+	teamName := acctest.RandomWithPrefix("tf-team")
+	projectName := acctest.RandomWithPrefix("tf-project")
+	version := acctest.RandomWithPrefix("tf-release")
+	rn := "sentry_release.test"
+
+	var releaseID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckSentryReleaseDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSentryReleaseConfig_projects(teamName, projectName, version, "[sentry_project.test.id]"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSentryReleaseExists(rn),
+					resource.TestCheckResourceAttr(rn, "projects.#", "1"),
+					resource.TestCheckResourceAttrWith(rn, "internal_id", func(value string) error {
+						releaseID = value
+						return nil
+					}),
+				),
+			},
+			{
+				Config: testAccSentryReleaseConfig_projects(teamName, projectName, version, "[sentry_project.test.id, sentry_project.other.id]"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSentryReleaseExists(rn),
+					resource.TestCheckResourceAttr(rn, "projects.#", "2"),
+					resource.TestCheckResourceAttrWith(rn, "internal_id", func(value string) error {
+						if value != releaseID {
+							return errors.New("release was recreated")
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSentryReleaseDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*sentry.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "sentry_release" {
+			continue
+		}
+
+		org, version, err := splitSentryReleaseID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		ctx := context.Background()
+		_, resp, err := client.Releases.Get(ctx, org, version)
+		if err == nil {
+			return errors.New("release still exists")
+		}
+		if resp.StatusCode != 404 {
+			return err
+		}
+		return nil
+	}
+	return nil
+}
+
+func testAccCheckSentryReleaseExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("no ID is set")
+		}
+
+		org, version, err := splitSentryReleaseID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*sentry.Client)
+		ctx := context.Background()
+		_, _, err = client.Releases.Get(ctx, org, version)
+		return err
+	}
+}
+
+func testAccSentryReleaseConfig(teamName, projectName, version, dateReleased string) string {
+	config := testAccSentryProjectConfig_team(teamName, projectName)
+	if dateReleased == "" {
+		return config + fmt.Sprintf(`
+resource "sentry_release" "test" {
+	organization = sentry_project.test.organization
+	version      = %[1]q
+	projects     = [sentry_project.test.id]
+}
+		`, version)
+	}
+	return config + fmt.Sprintf(`
+resource "sentry_release" "test" {
+	organization  = sentry_project.test.organization
+	version       = %[1]q
+	projects      = [sentry_project.test.id]
+	url           = "https://example.com/releases/%[1]s"
+	date_released = %[2]q
+}
+	`, version, dateReleased)
+}
+
+func testAccSentryReleaseConfig_projects(teamName, projectName, version, projects string) string {
+	return testAccSentryProjectConfig_team(teamName, projectName) + fmt.Sprintf(`
+resource "sentry_project" "other" {
+	organization = sentry_team.test.organization
+	team         = sentry_team.test.slug
+	name         = "%[1]s-other"
+	platform     = "go"
+}
+
+resource "sentry_release" "test" {
+	organization = sentry_project.test.organization
+	version      = %[2]q
+	projects     = %[3]s
+}
+	`, projectName, version, projects)
+}
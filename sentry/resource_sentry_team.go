@@ -2,7 +2,6 @@ package sentry
 
 import (
 	"context"
-	"net/http"
 
 	"github.com/getkevin/terraform-provider-sentry/sentry/lib"
 	"github.com/hashicorp/go-multierror"
@@ -81,7 +80,7 @@ func resourceSentryTeamCreate(ctx context.Context, d *schema.ResourceData, meta
 	tflog.Debug(ctx, "Creating team", map[string]interface{}{"org": org, "teamName": params.Name})
 	team, _, err := client.Teams.Create(ctx, org, params)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err, d)
 	}
 
 	d.SetId(sentry.StringValue(team.Slug))
@@ -97,12 +96,10 @@ func resourceSentryTeamRead(ctx context.Context, d *schema.ResourceData, meta in
 	tflog.Debug(ctx, "Reading team", map[string]interface{}{"org": org, "team": teamSlug})
 	team, _, err := client.Teams.Get(ctx, org, teamSlug)
 	if err != nil {
-		if sErr, ok := err.(*sentry.ErrorResponse); ok {
-			if sErr.Response.StatusCode == http.StatusNotFound {
-				tflog.Info(ctx, "Removing team from state because it no longer exists in Sentry", map[string]interface{}{"team": teamSlug})
-				d.SetId("")
-				return nil
-			}
+		if isNotFound(err) {
+			tflog.Info(ctx, "Removing team from state because it no longer exists in Sentry", map[string]interface{}{"team": teamSlug})
+			d.SetId("")
+			return nil
 		}
 		return diag.FromErr(err)
 	}
@@ -133,7 +130,7 @@ func resourceSentryTeamUpdate(ctx context.Context, d *schema.ResourceData, meta
 	tflog.Debug(ctx, "Updating team", map[string]interface{}{"org": org, "team": teamSlug})
 	team, _, err := client.Teams.Update(ctx, org, teamSlug, params)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err, d)
 	}
 
 	d.SetId(sentry.StringValue(team.Slug))
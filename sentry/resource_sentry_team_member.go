@@ -0,0 +1,212 @@
+package sentry
+
+import (
+	"context"
+
+	"github.com/getkevin/terraform-provider-sentry/sentry/lib"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceSentryTeamMember() *schema.Resource {
+	return &schema.Resource{
+		Description: "Sentry Team Member resource.",
+
+		CreateContext: resourceSentryTeamMemberCreate,
+		ReadContext:   resourceSentryTeamMemberRead,
+		UpdateContext: resourceSentryTeamMemberUpdate,
+		DeleteContext: resourceSentryTeamMemberDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"organization": {
+				Description: "The slug of the organization the team member belongs to.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"team": {
+				Description: "The slug of the team to add the member to.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"member_id": {
+				Description: "The ID of the member to add to the team.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"role": {
+				Description: "The role of the member in the team. Valid values are `contributor` and `admin`. " +
+					"When not set, resolve to the minimum team role given by this member's organization role.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{sentry.TeamRoleContributor, sentry.TeamRoleAdmin}, false),
+			},
+			"effective_role": {
+				Description: "The effective role of the member in the team. This represents the highest role, " +
+					"determined by comparing the lower role assigned by the member's organizational role " +
+					"with the role assigned by the member's team role.",
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceSentryTeamMemberCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*sentry.Client)
+
+	org := d.Get("organization").(string)
+	team := d.Get("team").(string)
+	memberID := d.Get("member_id").(string)
+
+	tflog.Debug(ctx, "Adding member to team", map[string]interface{}{
+		"org":      org,
+		"team":     team,
+		"memberID": memberID,
+	})
+	_, _, err := client.TeamMembers.Create(ctx, org, memberID, team)
+	if err != nil {
+		return diagFromErr(err, d)
+	}
+
+	d.SetId(buildThreePartID(org, team, memberID))
+
+	if v, ok := d.GetOk("role"); ok {
+		params := &sentry.UpdateTeamMemberParams{
+			TeamRole: sentry.String(v.(string)),
+		}
+		if _, _, err := client.TeamMembers.Update(ctx, org, memberID, team, params); err != nil {
+			return diagFromErr(err, d)
+		}
+	}
+
+	return resourceSentryTeamMemberRead(ctx, d, meta)
+}
+
+func resourceSentryTeamMemberRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*sentry.Client)
+
+	org, team, memberID, err := splitSentryTeamMemberID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	tflog.Debug(ctx, "Reading team member", map[string]interface{}{
+		"org":      org,
+		"team":     team,
+		"memberID": memberID,
+	})
+	member, _, err := client.OrganizationMembers.Get(ctx, org, memberID)
+	if found, err := checkClientGet(err, d); !found {
+		tflog.Info(ctx, "Removed team member from state because the organization member no longer exists in Sentry", map[string]interface{}{
+			"org":      org,
+			"memberID": memberID,
+		})
+		return diag.FromErr(err)
+	}
+
+	isMember := false
+	for _, t := range member.Teams {
+		if t == team {
+			isMember = true
+			break
+		}
+	}
+	if !isMember {
+		tflog.Info(ctx, "Removed team member from state because the member is no longer part of the team", map[string]interface{}{
+			"org":      org,
+			"team":     team,
+			"memberID": memberID,
+		})
+		d.SetId("")
+		return nil
+	}
+
+	var role string
+	for _, teamRole := range member.TeamRoles {
+		if teamRole.TeamSlug == team {
+			role = sentry.StringValue(teamRole.Role)
+			break
+		}
+	}
+
+	retErr := multierror.Append(
+		d.Set("organization", org),
+		d.Set("team", team),
+		d.Set("member_id", memberID),
+		d.Set("role", role),
+		d.Set("effective_role", effectiveTeamRole(member.Role, role)),
+	)
+	return diag.FromErr(retErr.ErrorOrNil())
+}
+
+func resourceSentryTeamMemberUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*sentry.Client)
+
+	org, team, memberID, err := splitSentryTeamMemberID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	params := &sentry.UpdateTeamMemberParams{
+		TeamRole: sentry.String(d.Get("role").(string)),
+	}
+
+	tflog.Debug(ctx, "Updating team member", map[string]interface{}{
+		"org":      org,
+		"team":     team,
+		"memberID": memberID,
+		"role":     *params.TeamRole,
+	})
+	_, _, err = client.TeamMembers.Update(ctx, org, memberID, team, params)
+	if err != nil {
+		return diagFromErr(err, d)
+	}
+
+	return resourceSentryTeamMemberRead(ctx, d, meta)
+}
+
+func resourceSentryTeamMemberDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*sentry.Client)
+
+	org, team, memberID, err := splitSentryTeamMemberID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	tflog.Debug(ctx, "Removing member from team", map[string]interface{}{
+		"org":      org,
+		"team":     team,
+		"memberID": memberID,
+	})
+	_, _, err = client.TeamMembers.Delete(ctx, org, memberID, team)
+	return diag.FromErr(err)
+}
+
+func splitSentryTeamMemberID(id string) (org string, team string, memberID string, err error) {
+	org, team, memberID, err = splitThreePartID(id, "organization-slug", "team-slug", "member-id")
+	return
+}
+
+// effectiveTeamRole returns the higher of the team role and the minimum team
+// role that is granted by the organization role.
+func effectiveTeamRole(orgRole string, teamRole string) string {
+	switch orgRole {
+	case sentry.RoleAdmin, sentry.RoleManager, sentry.RoleOwner:
+		return sentry.TeamRoleAdmin
+	}
+	if teamRole == sentry.TeamRoleAdmin {
+		return sentry.TeamRoleAdmin
+	}
+	return sentry.TeamRoleContributor
+}
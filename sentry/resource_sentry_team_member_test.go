@@ -0,0 +1,158 @@
+package sentry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/getkevin/terraform-provider-sentry/sentry/lib"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccSentryTeamMember_basic(t *testing.T) {
+	teamName := acctest.RandomWithPrefix("tf-team")
+	memberEmail := acctest.RandomWithPrefix("tf-member") + "@example.com"
+	rn := "sentry_team_member.test"
+
+	check := func(role string) resource.TestCheckFunc {
+		return resource.ComposeTestCheckFunc(
+			testAccCheckSentryTeamMemberExists(rn),
+			resource.TestCheckResourceAttrPair(rn, "team", "sentry_team.test", "id"),
+			resource.TestCheckResourceAttrPair(rn, "member_id", "sentry_organization_member.test", "internal_id"),
+			resource.TestCheckResourceAttr(rn, "role", role),
+			resource.TestCheckResourceAttr(rn, "effective_role", role),
+		)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckSentryTeamMemberDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSentryTeamMemberConfig(teamName, memberEmail, "contributor"),
+				Check:  check("contributor"),
+			},
+			{
+				Config: testAccSentryTeamMemberConfig(teamName, memberEmail, "admin"),
+				Check:  check("admin"),
+			},
+			{
+				// The member resource does not set teams, so it must not
+				// try to remove the membership managed above.
+				Config:   testAccSentryTeamMemberConfig(teamName, memberEmail, "admin"),
+				PlanOnly: true,
+			},
+			{
+				ResourceName:      rn,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestEffectiveTeamRole(t *testing.T) {
+	testCases := []struct {
+		orgRole  string
+		teamRole string
+		want     string
+	}{
+		{orgRole: "member", teamRole: "", want: "contributor"},
+		{orgRole: "member", teamRole: "contributor", want: "contributor"},
+		{orgRole: "member", teamRole: "admin", want: "admin"},
+		{orgRole: "billing", teamRole: "", want: "contributor"},
+		{orgRole: "admin", teamRole: "contributor", want: "admin"},
+		{orgRole: "manager", teamRole: "", want: "admin"},
+		{orgRole: "owner", teamRole: "contributor", want: "admin"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.orgRole+"/"+tc.teamRole, func(t *testing.T) {
+			if got := effectiveTeamRole(tc.orgRole, tc.teamRole); got != tc.want {
+				t.Errorf("got %q; want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func testAccCheckSentryTeamMemberDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*sentry.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "sentry_team_member" {
+			continue
+		}
+
+		org, team, memberID, err := splitSentryTeamMemberID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		ctx := context.Background()
+		member, resp, err := client.OrganizationMembers.Get(ctx, org, memberID)
+		if err == nil {
+			for _, t := range member.Teams {
+				if t == team {
+					return errors.New("team member still exists")
+				}
+			}
+			return nil
+		}
+		if resp.StatusCode != 404 {
+			return err
+		}
+		return nil
+	}
+	return nil
+}
+
+func testAccCheckSentryTeamMemberExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("no ID is set")
+		}
+
+		org, team, memberID, err := splitSentryTeamMemberID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*sentry.Client)
+		ctx := context.Background()
+		member, _, err := client.OrganizationMembers.Get(ctx, org, memberID)
+		if err != nil {
+			return err
+		}
+		for _, t := range member.Teams {
+			if t == team {
+				return nil
+			}
+		}
+		return fmt.Errorf("member %s is not part of team %s", memberID, team)
+	}
+}
+
+func testAccSentryTeamMemberConfig(teamName, email, role string) string {
+	return testAccSentryTeamConfig(teamName) + fmt.Sprintf(`
+resource "sentry_organization_member" "test" {
+	organization = data.sentry_organization.test.id
+	email        = "%[1]s"
+	role         = "member"
+}
+
+resource "sentry_team_member" "test" {
+	organization = data.sentry_organization.test.id
+	team         = sentry_team.test.id
+	member_id    = sentry_organization_member.test.internal_id
+	role         = "%[2]s"
+}
+	`, email, role)
+}
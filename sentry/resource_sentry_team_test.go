@@ -147,3 +147,23 @@ func TestValidatePlatform(t *testing.T) {
 		})
 	}
 }
+
+func TestValidatePlatform_invalid(t *testing.T) {
+	for tc, expected := range map[string]string{
+		"pyhton":                "pyhton is not a valid platform, did you mean python?",
+		"javascript-raect":      "javascript-raect is not a valid platform, did you mean javascript-react?",
+		"definitely-not-a-lang": "definitely-not-a-lang is not a valid platform",
+	} {
+		tc, expected := tc, expected
+		t.Run(tc, func(t *testing.T) {
+			t.Parallel()
+			diag := validatePlatform(tc, nil)
+			if !diag.HasError() {
+				t.Fatalf("platform should be invalid: %v", tc)
+			}
+			if diag[0].Detail != expected {
+				t.Errorf("got %q, want %q", diag[0].Detail, expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,127 @@
+package sentry
+
+import (
+	"context"
+	"strings"
+
+	"github.com/getkevin/terraform-provider-sentry/sentry/lib"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// scopeRequirement lists the auth token scopes a resource needs. Any one of
+// the listed scopes is sufficient.
+type scopeRequirement struct {
+	Read  []string
+	Write []string
+}
+
+var (
+	alertScopes        = scopeRequirement{Read: []string{"alerts:read", "project:read"}, Write: []string{"alerts:write", "project:write"}}
+	metricAlertScopes  = scopeRequirement{Read: []string{"alerts:read", "org:read"}, Write: []string{"alerts:write", "org:write"}}
+	integrationScopes  = scopeRequirement{Read: []string{"org:read"}, Write: []string{"org:integrations", "org:write"}}
+	memberScopes       = scopeRequirement{Read: []string{"member:read"}, Write: []string{"member:admin"}}
+	organizationScopes = scopeRequirement{Read: []string{"org:read"}, Write: []string{"org:write"}}
+	projectScopes      = scopeRequirement{Read: []string{"project:read"}, Write: []string{"project:write"}}
+	releaseScopes      = scopeRequirement{Read: []string{"project:releases", "project:read", "org:ci"}, Write: []string{"project:releases", "project:write", "org:ci"}}
+	teamScopes         = scopeRequirement{Read: []string{"team:read"}, Write: []string{"team:write"}}
+)
+
+// resourceScopes maps resources to the scopes they need.
+var resourceScopes = map[string]scopeRequirement{
+	"sentry_dashboard":                      {Read: []string{"org:read"}, Write: []string{"org:read"}},
+	"sentry_issue_alert":                    alertScopes,
+	"sentry_key":                            projectScopes,
+	"sentry_metric_alert":                   metricAlertScopes,
+	"sentry_notification_action":            organizationScopes,
+	"sentry_organization_code_mapping":      integrationScopes,
+	"sentry_organization_member":            memberScopes,
+	"sentry_organization_repository_github": integrationScopes,
+	"sentry_organization":                   organizationScopes,
+	"sentry_organization_settings":          organizationScopes,
+	"sentry_plugin":                         projectScopes,
+	"sentry_project":                        projectScopes,
+	"sentry_project_inbound_data_filter":    projectScopes,
+	"sentry_project_ownership":              projectScopes,
+	"sentry_project_spike_protection":       projectScopes,
+	"sentry_project_symbol_source":          projectScopes,
+	"sentry_release":                        releaseScopes,
+	"sentry_release_deployment":             releaseScopes,
+	"sentry_rule":                           alertScopes,
+	"sentry_team":                           teamScopes,
+	"sentry_team_member":                    {Read: []string{"member:read"}, Write: []string{"team:write", "member:write"}},
+}
+
+// dataSourceScopes maps data sources to the scopes they need.
+var dataSourceScopes = map[string]scopeRequirement{
+	"sentry_dashboard":                 {Read: []string{"org:read"}},
+	"sentry_issue_alert":               alertScopes,
+	"sentry_issue_alert_configuration": alertScopes,
+	"sentry_key":                       projectScopes,
+	"sentry_metric_alert":              metricAlertScopes,
+	"sentry_organization":              organizationScopes,
+	"sentry_organization_integration":  integrationScopes,
+	"sentry_organization_member":       memberScopes,
+	"sentry_project":                   projectScopes,
+	"sentry_projects":                  projectScopes,
+	"sentry_releases":                  releaseScopes,
+	"sentry_team":                      teamScopes,
+	"sentry_pagerduty_integration":     integrationScopes,
+}
+
+// requireScopes wraps the CRUD functions of a resource to check that the auth
+// token has the scopes the resource needs before calling Sentry.
+func requireScopes(name string, r *schema.Resource, req scopeRequirement) {
+	if r.CreateContext != nil {
+		r.CreateContext = withScopeCheck(name, "create", r.CreateContext, req.Write)
+	}
+	if r.ReadContext != nil {
+		r.ReadContext = withScopeCheck(name, "read", r.ReadContext, req.Read)
+	}
+	if r.UpdateContext != nil {
+		r.UpdateContext = withScopeCheck(name, "update", r.UpdateContext, req.Write)
+	}
+	if r.DeleteContext != nil {
+		r.DeleteContext = withScopeCheck(name, "delete", r.DeleteContext, req.Write)
+	}
+}
+
+func withScopeCheck(name, operation string, f func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics, scopes []string) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		if diags := checkScopes(meta, name, operation, scopes); diags.HasError() {
+			return diags
+		}
+		return f(ctx, d, meta)
+	}
+}
+
+// checkScopes returns an error if the cached scopes of the auth token grant
+// none of the scopes. Nothing is checked when the scopes have not been
+// loaded, e.g. because skip_scope_check is set.
+func checkScopes(meta interface{}, name, operation string, scopes []string) diag.Diagnostics {
+	client, ok := meta.(*sentry.Client)
+	if !ok || len(scopes) == 0 {
+		return nil
+	}
+	granted, ok := client.TokenScopes()
+	if !ok {
+		return nil
+	}
+	for _, scope := range scopes {
+		if sentry.HasScope(granted, scope) {
+			return nil
+		}
+	}
+
+	tokenScopes := "none"
+	if len(granted) > 0 {
+		tokenScopes = strings.Join(granted, ", ")
+	}
+	return diag.Diagnostics{{
+		Severity: diag.Error,
+		Summary:  "Auth token is missing a required scope",
+		Detail: "To " + operation + " " + name + ", the auth token needs one of the scopes " +
+			strings.Join(scopes, ", ") + ". The token has the scopes: " + tokenScopes + ". " +
+			"Grant the scope to the token, or set skip_scope_check to disable this check.",
+	}}
+}
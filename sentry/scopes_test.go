@@ -0,0 +1,105 @@
+package sentry
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/getkevin/terraform-provider-sentry/sentry/lib"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestScopes_allResourcesCovered(t *testing.T) {
+	p := NewProvider("dev")()
+	for name := range p.ResourcesMap {
+		if _, ok := resourceScopes[name]; !ok {
+			t.Errorf("resource %s has no scope requirement", name)
+		}
+	}
+	for name := range p.DataSourcesMap {
+		if _, ok := dataSourceScopes[name]; !ok {
+			t.Errorf("data source %s has no scope requirement", name)
+		}
+	}
+}
+
+func TestRequireScopes(t *testing.T) {
+	ctx := context.Background()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"version": "0", "auth": {"scopes": ["alerts:read", "project:read"]}}`)
+	}))
+	defer server.Close()
+
+	client, err := sentry.NewOnPremiseClient(server.URL+"/api/", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	calls := 0
+	noop := func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+		calls++
+		return nil
+	}
+	r := &schema.Resource{
+		CreateContext: noop,
+		ReadContext:   noop,
+		DeleteContext: noop,
+	}
+	requireScopes("sentry_issue_alert", r, alertScopes)
+
+	// Scopes which have not been loaded are not checked.
+	if diags := r.CreateContext(ctx, nil, client); diags.HasError() {
+		t.Fatalf("expected no error before loading scopes, got %v", diags)
+	}
+
+	if _, _, err := client.LoadTokenScopes(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	if diags := r.ReadContext(ctx, nil, client); diags.HasError() {
+		t.Fatalf("expected read to be allowed, got %v", diags)
+	}
+
+	diags := r.DeleteContext(ctx, nil, client)
+	if !diags.HasError() {
+		t.Fatal("expected delete to be rejected")
+	}
+	if detail := diags[0].Detail; !strings.Contains(detail, "alerts:write, project:write") || !strings.Contains(detail, "skip_scope_check") {
+		t.Errorf("unexpected detail: %s", detail)
+	}
+
+	if calls != 2 {
+		t.Errorf("expected 2 calls, got %d", calls)
+	}
+}
+
+func TestCheckScopes_organizationAuthToken(t *testing.T) {
+	ctx := context.Background()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"version": "0", "auth": {"scopes": ["org:ci"]}}`)
+	}))
+	defer server.Close()
+
+	client, err := sentry.NewOnPremiseClient(server.URL+"/api/", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := client.LoadTokenScopes(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	if diags := checkScopes(client, "sentry_release", "create", releaseScopes.Write); diags.HasError() {
+		t.Errorf("expected releases to be allowed, got %v", diags)
+	}
+	if diags := checkScopes(client, "sentry_project", "create", projectScopes.Write); !diags.HasError() {
+		t.Error("expected projects to be rejected")
+	}
+}
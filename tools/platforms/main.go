@@ -0,0 +1,58 @@
+// Command platforms generates the list of platforms known to Sentry, which the
+// provider embeds to validate the platform of a project without network access.
+//
+// The list is derived from the platform index of the Sentry documentation.
+// Each platform and each of its integrations is a valid platform, e.g.
+// "python" and "python-django".
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+)
+
+const header = "# Code generated by tools/platforms; DO NOT EDIT.\n"
+
+func main() {
+	indexURL := flag.String("url", "https://docs.sentry.io/_platforms/_index.json", "URL of the platform index")
+	output := flag.String("output", "platforms.txt", "file to write the platforms to")
+	flag.Parse()
+
+	resp, err := http.Get(*indexURL)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		log.Fatalf("GET %s: %s", *indexURL, resp.Status)
+	}
+
+	var index struct {
+		Platforms map[string]map[string]json.RawMessage `json:"platforms"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&index); err != nil {
+		log.Fatal(err)
+	}
+
+	platforms := []string{"other"}
+	for platform, integrations := range index.Platforms {
+		for integration := range integrations {
+			if integration == "_self" {
+				platforms = append(platforms, platform)
+			} else {
+				platforms = append(platforms, fmt.Sprintf("%s-%s", platform, integration))
+			}
+		}
+	}
+	sort.Strings(platforms)
+
+	if err := os.WriteFile(*output, []byte(header+strings.Join(platforms, "\n")+"\n"), 0o644); err != nil {
+		log.Fatal(err)
+	}
+}